@@ -0,0 +1,173 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// LaunchAlloyEvent wraps the alloy API call that creates an event from an event template and starts launching it
+//
+// param templateID: The id of the event template to launch
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created event and error on failure or nil on success
+func LaunchAlloyEvent(templateID string, m map[string]string) (*structs.AlloyEvent, error) {
+	log.Printf("! At top of API wrapper to launch event")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetAlloyApiUrl(m) + "eventTemplates/" + templateID + "/events"
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Alloy API returned with status code %d when launching event from template %s%s",
+			status, templateID, alloyProblemDetail(response))
+	}
+
+	event := &structs.AlloyEvent{}
+	err = json.NewDecoder(response.Body).Decode(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// ReadAlloyEvent wraps the alloy API call to read the fields of an event
+//
+// Param id: the id of the event to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the event on success
+func ReadAlloyEvent(id string, m map[string]string) (*structs.AlloyEvent, error) {
+	response, err := getAlloyEventByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Alloy API returned with status code %d when reading event", status)
+	}
+
+	event := &structs.AlloyEvent{}
+	err = json.NewDecoder(response.Body).Decode(event)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read event")
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// EndAlloyEvent wraps the alloy API call that starts ending an event. Alloy destroys the event's workspace, stops its
+// scenario and deletes its view in the background.
+//
+// Param id: The id of the event to end
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func EndAlloyEvent(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetAlloyApiUrl(m) + "events/" + id + "/end"
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return fmt.Errorf("Alloy API returned with status code %d when ending event %s%s", status, id,
+			alloyProblemDetail(response))
+	}
+	return nil
+}
+
+// AlloyEventExists returns whether an event exists along with an error value
+func AlloyEventExists(id string, m map[string]string) (bool, error) {
+	response, err := getAlloyEventByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets an event by its ID and returns the HTTP response
+func getAlloyEventByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetAlloyApiUrl(m) + "events/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Returns the title and detail of the problem details Alloy sends with an error, formatted to be appended to an error
+// message. Returns an empty string if the response has none.
+func alloyProblemDetail(response *http.Response) string {
+	problem := struct {
+		Title  string
+		Detail string
+	}{}
+
+	err := json.NewDecoder(response.Body).Decode(&problem)
+	if err != nil || (problem.Title == "" && problem.Detail == "") {
+		return ""
+	}
+	if problem.Detail == "" {
+		return ": " + problem.Title
+	}
+	return fmt.Sprintf(": %s %s", problem.Title, problem.Detail)
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateAlloyEventTemplate wraps the create event template POST call in alloy API
+//
+// param template: A struct containing the fields of the event template
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created event template and error on failure or nil on success
+func CreateAlloyEventTemplate(template *structs.AlloyEventTemplate, m map[string]string) (*structs.AlloyEventTemplate, error) {
+	log.Printf("! At top of API wrapper to create event template")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := alloyEventTemplatePayload(template)
+
+	log.Printf("! Creating event template with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetAlloyApiUrl(m)+"eventTemplates", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Alloy API returned with status code %d when creating event template", status)
+	}
+
+	created := &structs.AlloyEventTemplate{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadAlloyEventTemplate wraps the alloy API call to read the fields of an event template
+//
+// Param id: the id of the event template to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the event template on success
+func ReadAlloyEventTemplate(id string, m map[string]string) (*structs.AlloyEventTemplate, error) {
+	response, err := getAlloyEventTemplateByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Alloy API returned with status code %d when reading event template", status)
+	}
+
+	template := &structs.AlloyEventTemplate{}
+	err = json.NewDecoder(response.Body).Decode(template)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read event template")
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// UpdateAlloyEventTemplate wraps the alloy API call to update an event template
+//
+// param template: A struct containing the ID of the event template and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateAlloyEventTemplate(template *structs.AlloyEventTemplate, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := alloyEventTemplatePayload(template)
+	payload["id"] = template.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetAlloyApiUrl(m) + "eventTemplates/" + template.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Alloy API returned with status code %d when updating event template", status)
+	}
+	return nil
+}
+
+// DeleteAlloyEventTemplate wraps the alloy API call to delete an event template
+//
+// Param id: The id of the event template to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteAlloyEventTemplate(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetAlloyApiUrl(m) + "eventTemplates/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Alloy API returned with status code %d when deleting event template", status)
+	}
+	return nil
+}
+
+// AlloyEventTemplateExists returns whether an event template exists along with an error value
+func AlloyEventTemplateExists(id string, m map[string]string) (bool, error) {
+	response, err := getAlloyEventTemplateByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets an event template by its ID and returns the HTTP response
+func getAlloyEventTemplateByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetAlloyApiUrl(m) + "eventTemplates/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. Alloy expects null rather than an empty string for IDs that aren't set.
+func alloyEventTemplatePayload(template *structs.AlloyEventTemplate) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":               template.Name,
+		"description":        template.Description,
+		"viewId":             template.ViewId,
+		"directoryId":        nil,
+		"scenarioTemplateId": nil,
+		"durationHours":      template.DurationHours,
+		"useDynamicHost":     template.UseDynamicHost,
+		"isPublished":        template.IsPublished,
+	}
+
+	if template.DirectoryId != "" {
+		payload["directoryId"] = template.DirectoryId
+	}
+	if template.ScenarioTemplateId != "" {
+		payload["scenarioTemplateId"] = template.ScenarioTemplateId
+	}
+	return payload
+}
@@ -0,0 +1,217 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateBlueprintDataField wraps the create data field POST call in blueprint API
+//
+// param dataField: A struct containing the fields of the data field
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created data field and error on failure or nil on success
+func CreateBlueprintDataField(dataField *structs.BlueprintDataField, m map[string]string) (*structs.BlueprintDataField, error) {
+	log.Printf("! At top of API wrapper to create data field")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := blueprintDataFieldPayload(dataField)
+
+	log.Printf("! Creating data field with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetBlueprintApiUrl(m)+"dataFields", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when creating data field", status)
+	}
+
+	created := &structs.BlueprintDataField{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadBlueprintDataField wraps the blueprint API call to read the fields of a data field
+//
+// Param id: the id of the data field to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the data field on success
+func ReadBlueprintDataField(id string, m map[string]string) (*structs.BlueprintDataField, error) {
+	response, err := getBlueprintDataFieldByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when reading data field", status)
+	}
+
+	dataField := &structs.BlueprintDataField{}
+	err = json.NewDecoder(response.Body).Decode(dataField)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read data field")
+		return nil, err
+	}
+
+	return dataField, nil
+}
+
+// UpdateBlueprintDataField wraps the blueprint API call to update a data field
+//
+// param dataField: A struct containing the ID of the data field and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateBlueprintDataField(dataField *structs.BlueprintDataField, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := blueprintDataFieldPayload(dataField)
+	payload["id"] = dataField.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "dataFields/" + dataField.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Blueprint API returned with status code %d when updating data field", status)
+	}
+	return nil
+}
+
+// DeleteBlueprintDataField wraps the blueprint API call to delete a data field
+//
+// Param id: The id of the data field to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteBlueprintDataField(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "dataFields/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Blueprint API returned with status code %d when deleting data field", status)
+	}
+	return nil
+}
+
+// BlueprintDataFieldExists returns whether a data field exists along with an error value
+func BlueprintDataFieldExists(id string, m map[string]string) (bool, error) {
+	response, err := getBlueprintDataFieldByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a data field by its ID and returns the HTTP response
+func getBlueprintDataFieldByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "dataFields/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func blueprintDataFieldPayload(dataField *structs.BlueprintDataField) map[string]interface{} {
+	return map[string]interface{}{
+		"mselId":            dataField.MselId,
+		"name":              dataField.Name,
+		"dataType":          dataField.DataType,
+		"displayOrder":      dataField.DisplayOrder,
+		"isChosenFromList":  dataField.IsChosenFromList,
+		"isInitiallyHidden": dataField.IsInitiallyHidden,
+	}
+}
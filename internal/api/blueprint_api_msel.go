@@ -0,0 +1,230 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateBlueprintMsel wraps the create MSEL POST call in blueprint API
+//
+// param msel: A struct containing the fields of the MSEL
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created MSEL and error on failure or nil on success
+func CreateBlueprintMsel(msel *structs.BlueprintMsel, m map[string]string) (*structs.BlueprintMsel, error) {
+	log.Printf("! At top of API wrapper to create MSEL")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := blueprintMselPayload(msel)
+
+	log.Printf("! Creating MSEL with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetBlueprintApiUrl(m)+"msels", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when creating MSEL", status)
+	}
+
+	created := &structs.BlueprintMsel{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadBlueprintMsel wraps the blueprint API call to read the fields of a MSEL
+//
+// Param id: the id of the MSEL to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the MSEL on success
+func ReadBlueprintMsel(id string, m map[string]string) (*structs.BlueprintMsel, error) {
+	response, err := getBlueprintMselByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when reading MSEL", status)
+	}
+
+	msel := &structs.BlueprintMsel{}
+	err = json.NewDecoder(response.Body).Decode(msel)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read MSEL")
+		return nil, err
+	}
+
+	return msel, nil
+}
+
+// UpdateBlueprintMsel wraps the blueprint API call to update a MSEL
+//
+// param msel: A struct containing the ID of the MSEL and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateBlueprintMsel(msel *structs.BlueprintMsel, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := blueprintMselPayload(msel)
+	payload["id"] = msel.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "msels/" + msel.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Blueprint API returned with status code %d when updating MSEL", status)
+	}
+	return nil
+}
+
+// DeleteBlueprintMsel wraps the blueprint API call to delete a MSEL
+//
+// Param id: The id of the MSEL to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteBlueprintMsel(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "msels/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Blueprint API returned with status code %d when deleting MSEL", status)
+	}
+	return nil
+}
+
+// BlueprintMselExists returns whether a MSEL exists along with an error value
+func BlueprintMselExists(id string, m map[string]string) (bool, error) {
+	response, err := getBlueprintMselByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a MSEL by its ID and returns the HTTP response
+func getBlueprintMselByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "msels/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. Blueprint expects null rather than an empty string for IDs and times
+// that aren't set.
+func blueprintMselPayload(msel *structs.BlueprintMsel) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":            msel.Name,
+		"description":     msel.Description,
+		"status":          msel.Status,
+		"isTemplate":      msel.IsTemplate,
+		"playerViewId":    nil,
+		"useGallery":      msel.UseGallery,
+		"useCite":         msel.UseCite,
+		"useSteamfitter":  msel.UseSteamfitter,
+		"startTime":       nil,
+		"durationSeconds": msel.DurationSeconds,
+	}
+
+	if msel.PlayerViewId != "" {
+		payload["playerViewId"] = msel.PlayerViewId
+	}
+	if msel.StartTime != "" {
+		payload["startTime"] = msel.StartTime
+	}
+	return payload
+}
@@ -0,0 +1,226 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateBlueprintScenarioEvent wraps the create scenario event POST call in blueprint API
+//
+// param event: A struct containing the fields of the scenario event
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created scenario event and error on failure or nil on success
+func CreateBlueprintScenarioEvent(event *structs.BlueprintScenarioEvent, m map[string]string) (*structs.BlueprintScenarioEvent, error) {
+	log.Printf("! At top of API wrapper to create scenario event")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := blueprintScenarioEventPayload(event)
+
+	log.Printf("! Creating scenario event with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetBlueprintApiUrl(m)+"scenarioEvents", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when creating scenario event", status)
+	}
+
+	created := &structs.BlueprintScenarioEvent{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadBlueprintScenarioEvent wraps the blueprint API call to read the fields of a scenario event
+//
+// Param id: the id of the scenario event to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scenario event on success
+func ReadBlueprintScenarioEvent(id string, m map[string]string) (*structs.BlueprintScenarioEvent, error) {
+	response, err := getBlueprintScenarioEventByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when reading scenario event", status)
+	}
+
+	event := &structs.BlueprintScenarioEvent{}
+	err = json.NewDecoder(response.Body).Decode(event)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read scenario event")
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// UpdateBlueprintScenarioEvent wraps the blueprint API call to update a scenario event
+//
+// param event: A struct containing the ID of the scenario event and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateBlueprintScenarioEvent(event *structs.BlueprintScenarioEvent, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := blueprintScenarioEventPayload(event)
+	payload["id"] = event.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "scenarioEvents/" + event.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Blueprint API returned with status code %d when updating scenario event", status)
+	}
+	return nil
+}
+
+// DeleteBlueprintScenarioEvent wraps the blueprint API call to delete a scenario event
+//
+// Param id: The id of the scenario event to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteBlueprintScenarioEvent(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "scenarioEvents/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Blueprint API returned with status code %d when deleting scenario event", status)
+	}
+	return nil
+}
+
+// BlueprintScenarioEventExists returns whether a scenario event exists along with an error value
+func BlueprintScenarioEventExists(id string, m map[string]string) (bool, error) {
+	response, err := getBlueprintScenarioEventByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a scenario event by its ID and returns the HTTP response
+func getBlueprintScenarioEventByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "scenarioEvents/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func blueprintScenarioEventPayload(event *structs.BlueprintScenarioEvent) map[string]interface{} {
+	dataValues := make([]map[string]interface{}, 0, len(event.DataValues))
+	for _, value := range event.DataValues {
+		dataValues = append(dataValues, map[string]interface{}{
+			"dataFieldId": value.DataFieldId,
+			"value":       value.Value,
+		})
+	}
+
+	return map[string]interface{}{
+		"mselId":            event.MselId,
+		"groupOrder":        event.GroupOrder,
+		"deltaSeconds":      event.DeltaSeconds,
+		"scenarioEventType": event.ScenarioEventType,
+		"description":       event.Description,
+		"isHidden":          event.IsHidden,
+		"dataValues":        dataValues,
+	}
+}
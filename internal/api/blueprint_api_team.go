@@ -0,0 +1,215 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateBlueprintTeam wraps the create team POST call in blueprint API
+//
+// param team: A struct containing the fields of the team
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created team and error on failure or nil on success
+func CreateBlueprintTeam(team *structs.BlueprintTeam, m map[string]string) (*structs.BlueprintTeam, error) {
+	log.Printf("! At top of API wrapper to create team")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := blueprintTeamPayload(team)
+
+	log.Printf("! Creating team with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetBlueprintApiUrl(m)+"teams", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when creating team", status)
+	}
+
+	created := &structs.BlueprintTeam{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadBlueprintTeam wraps the blueprint API call to read the fields of a team
+//
+// Param id: the id of the team to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the team on success
+func ReadBlueprintTeam(id string, m map[string]string) (*structs.BlueprintTeam, error) {
+	response, err := getBlueprintTeamByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Blueprint API returned with status code %d when reading team", status)
+	}
+
+	team := &structs.BlueprintTeam{}
+	err = json.NewDecoder(response.Body).Decode(team)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read team")
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// UpdateBlueprintTeam wraps the blueprint API call to update a team
+//
+// param team: A struct containing the ID of the team and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateBlueprintTeam(team *structs.BlueprintTeam, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := blueprintTeamPayload(team)
+	payload["id"] = team.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "teams/" + team.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Blueprint API returned with status code %d when updating team", status)
+	}
+	return nil
+}
+
+// DeleteBlueprintTeam wraps the blueprint API call to delete a team
+//
+// Param id: The id of the team to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteBlueprintTeam(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "teams/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Blueprint API returned with status code %d when deleting team", status)
+	}
+	return nil
+}
+
+// BlueprintTeamExists returns whether a team exists along with an error value
+func BlueprintTeamExists(id string, m map[string]string) (bool, error) {
+	response, err := getBlueprintTeamByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a team by its ID and returns the HTTP response
+func getBlueprintTeamByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetBlueprintApiUrl(m) + "teams/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func blueprintTeamPayload(team *structs.BlueprintTeam) map[string]interface{} {
+	return map[string]interface{}{
+		"mselId":    team.MselId,
+		"name":      team.Name,
+		"shortName": team.ShortName,
+		"email":     team.Email,
+	}
+}
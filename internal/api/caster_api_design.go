@@ -0,0 +1,210 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterDesign wraps the create design POST call in caster API
+//
+// param design: A struct containing the project and name of the design
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created design and error on failure or nil on success
+func CreateCasterDesign(design *structs.CasterDesign, m map[string]string) (*structs.CasterDesign, error) {
+	log.Printf("! At top of API wrapper to create design")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"projectId": design.ProjectId,
+		"name":      design.Name,
+	}
+
+	log.Printf("! Creating design with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"designs", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating design", status)
+	}
+
+	created := &structs.CasterDesign{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterDesign wraps the caster API call to read the fields of a design
+//
+// Param id: the id of the design to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the design on success
+func ReadCasterDesign(id string, m map[string]string) (*structs.CasterDesign, error) {
+	response, err := getCasterDesignByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading design", status)
+	}
+
+	design := &structs.CasterDesign{}
+	err = json.NewDecoder(response.Body).Decode(design)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read design")
+		return nil, err
+	}
+
+	return design, nil
+}
+
+// UpdateCasterDesign wraps the caster API call to update a design
+//
+// param design: A struct containing the ID of the design and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterDesign(design *structs.CasterDesign, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":   design.Id,
+		"name": design.Name,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "designs/" + design.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating design", status)
+	}
+	return nil
+}
+
+// DeleteCasterDesign wraps the caster API call to delete a design
+//
+// Param id: The id of the design to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterDesign(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "designs/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting design", status)
+	}
+	return nil
+}
+
+// CasterDesignExists returns whether a design exists along with an error value
+func CasterDesignExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterDesignByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a design by its ID and returns the HTTP response
+func getCasterDesignByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "designs/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
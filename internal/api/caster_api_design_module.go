@@ -0,0 +1,217 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterDesignModule wraps the create design module POST call in caster API
+//
+// param design module: A struct containing the design, module, version and variable values of the design module
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created design module and error on failure or nil on success
+func CreateCasterDesignModule(designModule *structs.CasterDesignModule, m map[string]string) (*structs.CasterDesignModule, error) {
+	log.Printf("! At top of API wrapper to create design module")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"designId":      designModule.DesignId,
+		"moduleId":      designModule.ModuleId,
+		"name":          designModule.Name,
+		"moduleVersion": designModule.ModuleVersion,
+		"variables":     designModule.Variables,
+		"enabled":       designModule.Enabled,
+	}
+
+	log.Printf("! Creating design module with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"designModules", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating design module", status)
+	}
+
+	created := &structs.CasterDesignModule{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterDesignModule wraps the caster API call to read the fields of a design module
+//
+// Param id: the id of the design module to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the design module on success
+func ReadCasterDesignModule(id string, m map[string]string) (*structs.CasterDesignModule, error) {
+	response, err := getCasterDesignModuleByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading design module", status)
+	}
+
+	designModule := &structs.CasterDesignModule{}
+	err = json.NewDecoder(response.Body).Decode(designModule)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read design module")
+		return nil, err
+	}
+
+	return designModule, nil
+}
+
+// UpdateCasterDesignModule wraps the caster API call to update a design module
+//
+// param design module: A struct containing the ID of the design module and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterDesignModule(designModule *structs.CasterDesignModule, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":            designModule.Id,
+		"name":          designModule.Name,
+		"moduleVersion": designModule.ModuleVersion,
+		"variables":     designModule.Variables,
+		"enabled":       designModule.Enabled,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "designModules/" + designModule.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating design module", status)
+	}
+	return nil
+}
+
+// DeleteCasterDesignModule wraps the caster API call to delete a design module
+//
+// Param id: The id of the design module to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterDesignModule(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "designModules/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting design module", status)
+	}
+	return nil
+}
+
+// CasterDesignModuleExists returns whether a design module exists along with an error value
+func CasterDesignModuleExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterDesignModuleByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a design module by its ID and returns the HTTP response
+func getCasterDesignModuleByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "designModules/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
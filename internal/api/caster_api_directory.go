@@ -0,0 +1,214 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterDirectory wraps the create directory POST call in caster API
+//
+// param directory: A struct containing the project, parent, name and Terraform version of the directory
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created directory and error on failure or nil on success
+func CreateCasterDirectory(directory *structs.CasterDirectory, m map[string]string) (*structs.CasterDirectory, error) {
+	log.Printf("! At top of API wrapper to create directory")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"projectId":        directory.ProjectId,
+		"parentId":         util.Ternary(directory.ParentId == "", nil, directory.ParentId),
+		"name":             directory.Name,
+		"terraformVersion": util.Ternary(directory.TerraformVersion == "", nil, directory.TerraformVersion),
+	}
+
+	log.Printf("! Creating directory with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"directories", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating directory", status)
+	}
+
+	created := &structs.CasterDirectory{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterDirectory wraps the caster API call to read the fields of a directory
+//
+// Param id: the id of the directory to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the directory on success
+func ReadCasterDirectory(id string, m map[string]string) (*structs.CasterDirectory, error) {
+	response, err := getCasterDirectoryByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading directory", status)
+	}
+
+	directory := &structs.CasterDirectory{}
+	err = json.NewDecoder(response.Body).Decode(directory)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read directory")
+		return nil, err
+	}
+
+	return directory, nil
+}
+
+// UpdateCasterDirectory wraps the caster API call to update a directory
+//
+// param directory: A struct containing the ID of the directory and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterDirectory(directory *structs.CasterDirectory, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":               directory.Id,
+		"parentId":         util.Ternary(directory.ParentId == "", nil, directory.ParentId),
+		"name":             directory.Name,
+		"terraformVersion": util.Ternary(directory.TerraformVersion == "", nil, directory.TerraformVersion),
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "directories/" + directory.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating directory", status)
+	}
+	return nil
+}
+
+// DeleteCasterDirectory wraps the caster API call to delete a directory
+//
+// Param id: The id of the directory to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterDirectory(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "directories/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting directory", status)
+	}
+	return nil
+}
+
+// CasterDirectoryExists returns whether a directory exists along with an error value
+func CasterDirectoryExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterDirectoryByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a directory by its ID and returns the HTTP response
+func getCasterDirectoryByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "directories/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
@@ -0,0 +1,264 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterFile wraps the create file POST call in caster API
+//
+// param file: A struct containing the directory, name and content of the file
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created file and error on failure or nil on success
+func CreateCasterFile(file *structs.CasterFile, m map[string]string) (*structs.CasterFile, error) {
+	log.Printf("! At top of API wrapper to create file")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"directoryId": file.DirectoryId,
+		"workspaceId": util.Ternary(file.WorkspaceId == "", nil, file.WorkspaceId),
+		"name":        file.Name,
+		"content":     file.Content,
+	}
+
+	log.Printf("! Creating file %s in directory %s", file.Name, file.DirectoryId)
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"files", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating file", status)
+	}
+
+	created := &structs.CasterFile{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterFile wraps the caster API call to read the fields of a file
+//
+// Param id: the id of the file to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the file on success
+func ReadCasterFile(id string, m map[string]string) (*structs.CasterFile, error) {
+	response, err := getCasterFileByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading file", status)
+	}
+
+	file := &structs.CasterFile{}
+	err = json.NewDecoder(response.Body).Decode(file)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read file")
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// UpdateCasterFile wraps the caster API call to update a file. The file is locked while it is updated.
+//
+// param file: A struct containing the ID of the file and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterFile(file *structs.CasterFile, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	// Caster only allows editing a file while it is locked by the current user
+	err = lockCasterFile(file.Id, "lock", m)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		unlockErr := lockCasterFile(file.Id, "unlock", m)
+		if unlockErr != nil {
+			log.Printf("! Failed to unlock file %s: %v", file.Id, unlockErr)
+		}
+	}()
+
+	payload := map[string]interface{}{
+		"id":          file.Id,
+		"directoryId": file.DirectoryId,
+		"workspaceId": util.Ternary(file.WorkspaceId == "", nil, file.WorkspaceId),
+		"name":        file.Name,
+		"content":     file.Content,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "files/" + file.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if casterLocked(status) {
+		return fmt.Errorf("error updating file %s: %w", file.Id, ErrCasterLocked)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating file", status)
+	}
+	return nil
+}
+
+// DeleteCasterFile wraps the caster API call to delete a file
+//
+// Param id: The id of the file to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterFile(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "files/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if casterLocked(status) {
+		return fmt.Errorf("error deleting file %s: %w", id, ErrCasterLocked)
+	}
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting file", status)
+	}
+	return nil
+}
+
+// CasterFileExists returns whether a file exists along with an error value
+func CasterFileExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterFileByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a file by its ID and returns the HTTP response
+func getCasterFileByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "files/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Locks or unlocks a file for editing. Action is either "lock" or "unlock"
+func lockCasterFile(id, action string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "files/" + id + "/actions/" + action
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if casterLocked(status) {
+		return fmt.Errorf("error trying to %s file %s: %w", action, id, ErrCasterLocked)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when trying to %s file %s", status, action, id)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterHost wraps the create host POST call in caster API
+//
+// param host: A struct containing the name, datastore, machine limit and flags of the host
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created host and error on failure or nil on success
+func CreateCasterHost(host *structs.CasterHost, m map[string]string) (*structs.CasterHost, error) {
+	log.Printf("! At top of API wrapper to create host")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"name":            host.Name,
+		"datastore":       host.Datastore,
+		"maximumMachines": host.MaximumMachines,
+		"enabled":         host.Enabled,
+		"development":     host.Development,
+	}
+
+	log.Printf("! Creating host with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"hosts", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating host", status)
+	}
+
+	created := &structs.CasterHost{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterHost wraps the caster API call to read the fields of a host
+//
+// Param id: the id of the host to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the host on success
+func ReadCasterHost(id string, m map[string]string) (*structs.CasterHost, error) {
+	response, err := getCasterHostByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading host", status)
+	}
+
+	host := &structs.CasterHost{}
+	err = json.NewDecoder(response.Body).Decode(host)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read host")
+		return nil, err
+	}
+
+	return host, nil
+}
+
+// UpdateCasterHost wraps the caster API call to update a host
+//
+// param host: A struct containing the ID of the host and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterHost(host *structs.CasterHost, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":              host.Id,
+		"name":            host.Name,
+		"datastore":       host.Datastore,
+		"maximumMachines": host.MaximumMachines,
+		"enabled":         host.Enabled,
+		"development":     host.Development,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "hosts/" + host.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating host", status)
+	}
+	return nil
+}
+
+// DeleteCasterHost wraps the caster API call to delete a host
+//
+// Param id: The id of the host to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterHost(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "hosts/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting host", status)
+	}
+	return nil
+}
+
+// CasterHostExists returns whether a host exists along with an error value
+func CasterHostExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterHostByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// ListCasterHosts wraps the caster API call to list hosts
+//
+// Param projectID: If set, only the hosts assigned to this project are listed
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the hosts on success
+func ListCasterHosts(projectID string, m map[string]string) ([]structs.CasterHost, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "hosts"
+	if projectID != "" {
+		url = util.GetCasterApiUrl(m) + "projects/" + projectID + "/hosts"
+	}
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when listing hosts", status)
+	}
+
+	hosts := []structs.CasterHost{}
+	err = json.NewDecoder(response.Body).Decode(&hosts)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list hosts")
+		return nil, err
+	}
+
+	return hosts, nil
+}
+
+// AssignCasterHost wraps the caster API call that assigns a host to a project, so the project's dynamic host
+// workspaces can be deployed to it
+//
+// param projectID: The id of the project
+//
+// param hostID: The id of the host
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func AssignCasterHost(projectID, hostID string, m map[string]string) error {
+	return changeCasterHostAssignment("PUT", projectID, hostID, m)
+}
+
+// UnassignCasterHost wraps the caster API call that removes a host from a project
+//
+// param projectID: The id of the project
+//
+// param hostID: The id of the host
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UnassignCasterHost(projectID, hostID string, m map[string]string) error {
+	return changeCasterHostAssignment("DELETE", projectID, hostID, m)
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a host by its ID and returns the HTTP response
+func getCasterHostByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "hosts/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Assigns a host to a project with PUT, or unassigns it with DELETE
+func changeCasterHostAssignment(method, projectID, hostID string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "projects/" + projectID + "/hosts/" + hostID
+	request, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if method == "DELETE" && status == http.StatusNotFound {
+		return nil
+	}
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when changing assignment of host %s to project %s", status, hostID, projectID)
+	}
+	return nil
+}
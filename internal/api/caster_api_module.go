@@ -0,0 +1,215 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterModule wraps the create module POST call in caster API
+//
+// param module: A struct containing the name, source, version and description of the module
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created module and error on failure or nil on success
+func CreateCasterModule(module *structs.CasterModule, m map[string]string) (*structs.CasterModule, error) {
+	log.Printf("! At top of API wrapper to create module")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"name":        module.Name,
+		"source":      module.Source,
+		"version":     module.Version,
+		"description": module.Description,
+	}
+
+	log.Printf("! Creating module with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"modules", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating module", status)
+	}
+
+	created := &structs.CasterModule{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterModule wraps the caster API call to read the fields of a module
+//
+// Param id: the id of the module to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the module on success
+func ReadCasterModule(id string, m map[string]string) (*structs.CasterModule, error) {
+	response, err := getCasterModuleByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading module", status)
+	}
+
+	module := &structs.CasterModule{}
+	err = json.NewDecoder(response.Body).Decode(module)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read module")
+		return nil, err
+	}
+
+	return module, nil
+}
+
+// UpdateCasterModule wraps the caster API call to update a module
+//
+// param module: A struct containing the ID of the module and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterModule(module *structs.CasterModule, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":          module.Id,
+		"name":        module.Name,
+		"source":      module.Source,
+		"version":     module.Version,
+		"description": module.Description,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "modules/" + module.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating module", status)
+	}
+	return nil
+}
+
+// DeleteCasterModule wraps the caster API call to delete a module
+//
+// Param id: The id of the module to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterModule(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "modules/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting module", status)
+	}
+	return nil
+}
+
+// CasterModuleExists returns whether a module exists along with an error value
+func CasterModuleExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterModuleByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a module by its ID and returns the HTTP response
+func getCasterModuleByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "modules/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
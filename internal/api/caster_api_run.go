@@ -0,0 +1,210 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterRun wraps the caster API call that queues a plan in a workspace
+//
+// param workspaceID: The id of the workspace to run Terraform in
+//
+// param isDestroy: Whether the run should destroy the workspace's resources
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the queued run and error on failure or nil on success
+func CreateCasterRun(workspaceID string, isDestroy bool, m map[string]string) (*structs.CasterRun, error) {
+	log.Printf("! At top of API wrapper to create run")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	asJSON, err := json.Marshal(map[string]interface{}{
+		"workspaceId": workspaceID,
+		"isDestroy":   isDestroy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"runs", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating run in workspace %s", status, workspaceID)
+	}
+
+	run := &structs.CasterRun{}
+	err = json.NewDecoder(response.Body).Decode(run)
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// ReadCasterRun wraps the caster API call to read the fields of a run
+//
+// Param id: the id of the run to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the run on success
+func ReadCasterRun(id string, m map[string]string) (*structs.CasterRun, error) {
+	response, err := getCasterRunByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading run", status)
+	}
+
+	run := &structs.CasterRun{}
+	err = json.NewDecoder(response.Body).Decode(run)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read run")
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// ApplyCasterRun wraps the caster API call to apply a run that has been planned
+//
+// Param id: the id of the run to apply
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func ApplyCasterRun(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "runs/" + id + "/actions/apply"
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when applying run %s", status, id)
+	}
+	return nil
+}
+
+// GetCasterRunOutput wraps the caster API calls to read the Terraform output of a run. The output of the apply is
+// returned if the run was applied, otherwise the output of the plan.
+//
+// Param run: the run to get the output of
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the output on success
+func GetCasterRunOutput(run *structs.CasterRun, m map[string]string) (string, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return "", err
+	}
+
+	url := util.GetCasterApiUrl(m) + "plans/" + run.PlanId
+	if run.ApplyId != "" {
+		url = util.GetCasterApiUrl(m) + "applies/" + run.ApplyId
+	}
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return "", fmt.Errorf("Caster API returned with status code %d when reading output of run %s", status, run.Id)
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(response.Body).Decode(&body)
+	if err != nil {
+		return "", err
+	}
+
+	output, _ := body["output"].(string)
+	return output, nil
+}
+
+// CasterRunExists returns whether a run exists along with an error value
+func CasterRunExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterRunByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a run by its ID and returns the HTTP response
+func getCasterRunByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "runs/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
@@ -38,7 +38,7 @@ func CreateVlan(command *structs.VlanCreateCommand, m map[string]string) (*struc
 		"vlanId":      util.Ternary(!command.VlanId.Valid, nil, command.VlanId.Int32),
 	}
 
-	log.Printf("! Creating vlan with payload %+v", payload)
+	log.Printf("! Creating vlan with payload %s", util.Redact(payload))
 
 	asJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -125,6 +125,64 @@ func ReadVlan(id string, m map[string]string) (*structs.Vlan, error) {
 	return vlan, nil
 }
 
+// UpdateVlan wraps the caster API call to update the mutable fields of an acquired vlan
+//
+// Param id: The id of the vlan to update
+//
+// Param tag: The new tag of the vlan
+//
+// Param reserved: Whether the vlan should be reserved
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the updated vlan on success
+func UpdateVlan(id, tag string, reserved bool, m map[string]string) (*structs.Vlan, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"tag":      util.Ternary(tag == "", nil, tag),
+		"reserved": reserved,
+	}
+
+	log.Printf("! Updating vlan %s with payload %s", id, util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "vlans/" + id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when updating vlan", status)
+	}
+
+	vlan := &structs.Vlan{}
+	err = json.NewDecoder(response.Body).Decode(vlan)
+	if err != nil {
+		return nil, err
+	}
+
+	return vlan, nil
+}
+
 // DeleteVlan wraps the caster API release vlan call
 //
 // Param id: The id of the vlan to release back into the pool
@@ -152,8 +210,59 @@ func DeleteVlan(id string, m map[string]string) error {
 	}
 
 	status := response.StatusCode
+	if casterLocked(status) {
+		return fmt.Errorf("error releasing vlan %s: %w", id, ErrCasterLocked)
+	}
 	if status != http.StatusOK {
 		return fmt.Errorf("Caster API returned with status code %d when deleting vlan", status)
 	}
 	return nil
 }
+
+// ListVlans wraps the caster API call to list every vlan in a partition, or in the partition assigned to a project
+//
+// Param partitionID: The id of the partition. Ignored if projectID is set
+//
+// Param projectID: The id of the project
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the vlans on success
+func ListVlans(partitionID, projectID string, m map[string]string) ([]structs.Vlan, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "partitions/" + partitionID + "/vlans"
+	if projectID != "" {
+		url = util.GetCasterApiUrl(m) + "projects/" + projectID + "/vlans"
+	}
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when listing vlans", status)
+	}
+
+	vlans := []structs.Vlan{}
+	err = json.NewDecoder(response.Body).Decode(&vlans)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list vlans")
+		return nil, err
+	}
+
+	return vlans, nil
+}
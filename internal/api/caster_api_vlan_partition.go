@@ -0,0 +1,339 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateVlanPartition wraps the create partition POST call in caster API
+//
+// param partition: A struct containing the pool, name, default flag and VLAN ranges of the partition
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created partition and error on failure or nil on success
+func CreateVlanPartition(partition *structs.VlanPartition, m map[string]string) (*structs.VlanPartition, error) {
+	log.Printf("! At top of API wrapper to create vlan partition")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"poolId":    partition.PoolId,
+		"name":      partition.Name,
+		"isDefault": partition.IsDefault,
+		"ranges":    partition.Ranges,
+	}
+
+	log.Printf("! Creating vlan partition with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"partitions", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating vlan partition", status)
+	}
+
+	created := &structs.VlanPartition{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadVlanPartition wraps the caster API call to read the fields of a vlan partition
+//
+// Param id: the id of the partition to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the partition on success
+func ReadVlanPartition(id string, m map[string]string) (*structs.VlanPartition, error) {
+	response, err := getVlanPartitionByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading vlan partition", status)
+	}
+
+	partition := &structs.VlanPartition{}
+	err = json.NewDecoder(response.Body).Decode(partition)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read vlan partition")
+		return nil, err
+	}
+
+	return partition, nil
+}
+
+// UpdateVlanPartition wraps the caster API call to update a vlan partition
+//
+// param partition: A struct containing the ID of the partition and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateVlanPartition(partition *structs.VlanPartition, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":        partition.Id,
+		"poolId":    partition.PoolId,
+		"name":      partition.Name,
+		"isDefault": partition.IsDefault,
+		"ranges":    partition.Ranges,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "partitions/" + partition.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating vlan partition", status)
+	}
+	return nil
+}
+
+// DeleteVlanPartition wraps the caster API call to delete a vlan partition
+//
+// Param id: The id of the partition to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteVlanPartition(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "partitions/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting vlan partition", status)
+	}
+	return nil
+}
+
+// VlanPartitionExists returns whether a vlan partition exists along with an error value
+func VlanPartitionExists(id string, m map[string]string) (bool, error) {
+	response, err := getVlanPartitionByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// AssignPartitionToProject wraps the caster API call that sets the partition a project acquires its VLANs from
+//
+// param projectID: The id of the project
+//
+// param partitionID: The id of the partition
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func AssignPartitionToProject(projectID, partitionID string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	asJSON, err := json.Marshal(map[string]interface{}{
+		"partitionId": partitionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "projects/" + projectID + "/partition"
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when assigning partition %s to project %s", status, partitionID, projectID)
+	}
+	return nil
+}
+
+// ReadProjectPartition wraps the caster API call to read which partition a project is assigned to
+//
+// param projectID: The id of the project
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the id of the partition, or an empty string if the project does not exist or uses the default partition,
+// and an error value
+func ReadProjectPartition(projectID string, m map[string]string) (string, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return "", err
+	}
+
+	url := util.GetCasterApiUrl(m) + "projects/" + projectID
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status == http.StatusNotFound {
+		return "", nil
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("Caster API returned with status code %d when reading partition of project %s", status, projectID)
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(response.Body).Decode(&body)
+	if err != nil {
+		return "", err
+	}
+
+	partitionID, _ := body["partitionId"].(string)
+	return partitionID, nil
+}
+
+// UnassignProjectPartition wraps the caster API call that returns a project to the default partition
+//
+// param projectID: The id of the project
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UnassignProjectPartition(projectID string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "projects/" + projectID + "/partition"
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent && status != http.StatusNotFound {
+		return fmt.Errorf("Caster API returned with status code %d when unassigning partition from project %s", status, projectID)
+	}
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a vlan partition by its ID and returns the HTTP response
+func getVlanPartitionByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "partitions/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateVlanPool wraps the create pool POST call in caster API
+//
+// param pool: A struct containing the name and VLAN ranges of the pool
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created pool and error on failure or nil on success
+func CreateVlanPool(pool *structs.VlanPool, m map[string]string) (*structs.VlanPool, error) {
+	log.Printf("! At top of API wrapper to create vlan pool")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"name":   pool.Name,
+		"ranges": pool.Ranges,
+	}
+
+	log.Printf("! Creating vlan pool with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"pools", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating vlan pool", status)
+	}
+
+	created := &structs.VlanPool{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadVlanPool wraps the caster API call to read the fields of a vlan pool
+//
+// Param id: the id of the pool to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the pool on success
+func ReadVlanPool(id string, m map[string]string) (*structs.VlanPool, error) {
+	response, err := getVlanPoolByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading vlan pool", status)
+	}
+
+	pool := &structs.VlanPool{}
+	err = json.NewDecoder(response.Body).Decode(pool)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read vlan pool")
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// UpdateVlanPool wraps the caster API call to update a vlan pool
+//
+// param pool: A struct containing the ID of the pool and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateVlanPool(pool *structs.VlanPool, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":     pool.Id,
+		"name":   pool.Name,
+		"ranges": pool.Ranges,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "pools/" + pool.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating vlan pool", status)
+	}
+	return nil
+}
+
+// DeleteVlanPool wraps the caster API call to delete a vlan pool
+//
+// Param id: The id of the pool to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteVlanPool(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "pools/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting vlan pool", status)
+	}
+	return nil
+}
+
+// VlanPoolExists returns whether a vlan pool exists along with an error value
+func VlanPoolExists(id string, m map[string]string) (bool, error) {
+	response, err := getVlanPoolByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a vlan pool by its ID and returns the HTTP response
+func getVlanPoolByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "pools/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
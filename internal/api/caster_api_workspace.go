@@ -0,0 +1,263 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// ErrCasterLocked is returned when Caster refuses a change because a workspace is locked by a run in progress
+var ErrCasterLocked = errors.New("locked by a Caster run in progress")
+
+// -------------------- API Wrappers --------------------
+
+// CreateCasterWorkspace wraps the create workspace POST call in caster API
+//
+// param workspace: A struct containing the directory, name and dynamic host setting of the workspace
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created workspace and error on failure or nil on success
+func CreateCasterWorkspace(workspace *structs.CasterWorkspace, m map[string]string) (*structs.CasterWorkspace, error) {
+	log.Printf("! At top of API wrapper to create workspace")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"directoryId": workspace.DirectoryId,
+		"name":        workspace.Name,
+		"dynamicHost": workspace.DynamicHost,
+	}
+
+	log.Printf("! Creating workspace with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCasterApiUrl(m)+"workspaces", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Caster API returned with status code %d when creating workspace", status)
+	}
+
+	created := &structs.CasterWorkspace{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCasterWorkspace wraps the caster API call to read the fields of a workspace
+//
+// Param id: the id of the workspace to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the workspace on success
+func ReadCasterWorkspace(id string, m map[string]string) (*structs.CasterWorkspace, error) {
+	response, err := getCasterWorkspaceByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Caster API returned with status code %d when reading workspace", status)
+	}
+
+	workspace := &structs.CasterWorkspace{}
+	err = json.NewDecoder(response.Body).Decode(workspace)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read workspace")
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+// UpdateCasterWorkspace wraps the caster API call to update a workspace
+//
+// param workspace: A struct containing the ID of the workspace and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCasterWorkspace(workspace *structs.CasterWorkspace, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":          workspace.Id,
+		"directoryId": workspace.DirectoryId,
+		"name":        workspace.Name,
+		"dynamicHost": workspace.DynamicHost,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "workspaces/" + workspace.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if casterLocked(status) {
+		return fmt.Errorf("error updating workspace %s: %w", workspace.Id, ErrCasterLocked)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when updating workspace", status)
+	}
+	return nil
+}
+
+// DeleteCasterWorkspace wraps the caster API call to delete a workspace
+//
+// Param id: The id of the workspace to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCasterWorkspace(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "workspaces/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if casterLocked(status) {
+		return fmt.Errorf("error deleting workspace %s: %w", id, ErrCasterLocked)
+	}
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Caster API returned with status code %d when deleting workspace", status)
+	}
+	return nil
+}
+
+// CasterWorkspaceExists returns whether a workspace exists along with an error value
+func CasterWorkspaceExists(id string, m map[string]string) (bool, error) {
+	response, err := getCasterWorkspaceByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// UnlockCasterWorkspace wraps the caster API call to force a workspace's lock to be released. Any run holding the
+// lock is not stopped.
+//
+// Param id: The id of the workspace to unlock
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UnlockCasterWorkspace(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCasterApiUrl(m) + "workspaces/" + id + "/actions/unlock"
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Caster API returned with status code %d when unlocking workspace %s", status, id)
+	}
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Returns whether a status code means Caster refused a request because of a lock
+func casterLocked(status int) bool {
+	return status == http.StatusConflict || status == http.StatusLocked
+}
+
+// Gets a workspace by its ID and returns the HTTP response
+func getCasterWorkspaceByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCasterApiUrl(m) + "workspaces/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCiteEvaluation wraps the create evaluation POST call in cite API
+//
+// param evaluation: A struct containing the fields of the evaluation
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created evaluation and error on failure or nil on success
+func CreateCiteEvaluation(evaluation *structs.CiteEvaluation, m map[string]string) (*structs.CiteEvaluation, error) {
+	log.Printf("! At top of API wrapper to create evaluation")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := citeEvaluationPayload(evaluation)
+
+	log.Printf("! Creating evaluation with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCiteApiUrl(m)+"evaluations", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Cite API returned with status code %d when creating evaluation", status)
+	}
+
+	created := &structs.CiteEvaluation{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCiteEvaluation wraps the cite API call to read the fields of an evaluation
+//
+// Param id: the id of the evaluation to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the evaluation on success
+func ReadCiteEvaluation(id string, m map[string]string) (*structs.CiteEvaluation, error) {
+	response, err := getCiteEvaluationByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when reading evaluation", status)
+	}
+
+	evaluation := &structs.CiteEvaluation{}
+	err = json.NewDecoder(response.Body).Decode(evaluation)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read evaluation")
+		return nil, err
+	}
+
+	return evaluation, nil
+}
+
+// UpdateCiteEvaluation wraps the cite API call to update an evaluation
+//
+// param evaluation: A struct containing the ID of the evaluation and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCiteEvaluation(evaluation *structs.CiteEvaluation, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := citeEvaluationPayload(evaluation)
+	payload["id"] = evaluation.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "evaluations/" + evaluation.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Cite API returned with status code %d when updating evaluation", status)
+	}
+	return nil
+}
+
+// DeleteCiteEvaluation wraps the cite API call to delete an evaluation
+//
+// Param id: The id of the evaluation to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCiteEvaluation(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "evaluations/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Cite API returned with status code %d when deleting evaluation", status)
+	}
+	return nil
+}
+
+// CiteEvaluationExists returns whether an evaluation exists along with an error value
+func CiteEvaluationExists(id string, m map[string]string) (bool, error) {
+	response, err := getCiteEvaluationByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets an evaluation by its ID and returns the HTTP response
+func getCiteEvaluationByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "evaluations/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. CITE expects null rather than an empty string for IDs and times that
+// aren't set.
+func citeEvaluationPayload(evaluation *structs.CiteEvaluation) map[string]interface{} {
+	payload := map[string]interface{}{
+		"description":          evaluation.Description,
+		"scoringModelId":       evaluation.ScoringModelId,
+		"status":               evaluation.Status,
+		"currentMoveNumber":    evaluation.CurrentMoveNumber,
+		"situationTime":        nil,
+		"situationDescription": evaluation.SituationDescription,
+		"viewId":               nil,
+	}
+
+	if evaluation.SituationTime != "" {
+		payload["situationTime"] = evaluation.SituationTime
+	}
+	if evaluation.ViewId != "" {
+		payload["viewId"] = evaluation.ViewId
+	}
+	return payload
+}
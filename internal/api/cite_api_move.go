@@ -0,0 +1,264 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCiteMove wraps the create move POST call in cite API
+//
+// param move: A struct containing the fields of the move
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created move and error on failure or nil on success
+func CreateCiteMove(move *structs.CiteMove, m map[string]string) (*structs.CiteMove, error) {
+	log.Printf("! At top of API wrapper to create move")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := citeMovePayload(move)
+
+	log.Printf("! Creating move with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCiteApiUrl(m)+"moves", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Cite API returned with status code %d when creating move", status)
+	}
+
+	created := &structs.CiteMove{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCiteMove wraps the cite API call to read the fields of a move
+//
+// Param id: the id of the move to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the move on success
+func ReadCiteMove(id string, m map[string]string) (*structs.CiteMove, error) {
+	response, err := getCiteMoveByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when reading move", status)
+	}
+
+	move := &structs.CiteMove{}
+	err = json.NewDecoder(response.Body).Decode(move)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read move")
+		return nil, err
+	}
+
+	return move, nil
+}
+
+// UpdateCiteMove wraps the cite API call to update a move
+//
+// param move: A struct containing the ID of the move and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCiteMove(move *structs.CiteMove, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := citeMovePayload(move)
+	payload["id"] = move.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "moves/" + move.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Cite API returned with status code %d when updating move", status)
+	}
+	return nil
+}
+
+// DeleteCiteMove wraps the cite API call to delete a move
+//
+// Param id: The id of the move to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCiteMove(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "moves/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Cite API returned with status code %d when deleting move", status)
+	}
+	return nil
+}
+
+// ListCiteMoves wraps the cite API call to list the moves of an evaluation
+//
+// Param id: The id of the evaluation
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the moves on success
+func ListCiteMoves(id string, m map[string]string) ([]structs.CiteMove, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "evaluations/" + id + "/moves"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when listing moves of evaluation %s", status, id)
+	}
+
+	found := []structs.CiteMove{}
+	err = json.NewDecoder(response.Body).Decode(&found)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list moves")
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// CiteMoveExists returns whether a move exists along with an error value
+func CiteMoveExists(id string, m map[string]string) (bool, error) {
+	response, err := getCiteMoveByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a move by its ID and returns the HTTP response
+func getCiteMoveByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "moves/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. CITE expects null rather than an empty string for times that aren't
+// set.
+func citeMovePayload(move *structs.CiteMove) map[string]interface{} {
+	payload := map[string]interface{}{
+		"evaluationId":         move.EvaluationId,
+		"moveNumber":           move.MoveNumber,
+		"description":          move.Description,
+		"situationTime":        nil,
+		"situationDescription": move.SituationDescription,
+	}
+
+	if move.SituationTime != "" {
+		payload["situationTime"] = move.SituationTime
+	}
+	return payload
+}
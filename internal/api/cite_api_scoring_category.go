@@ -0,0 +1,260 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCiteScoringCategory wraps the create scoring category POST call in cite API
+//
+// param category: A struct containing the fields of the scoring category
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created scoring category and error on failure or nil on success
+func CreateCiteScoringCategory(category *structs.CiteScoringCategory, m map[string]string) (*structs.CiteScoringCategory, error) {
+	log.Printf("! At top of API wrapper to create scoring category")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := citeScoringCategoryPayload(category)
+
+	log.Printf("! Creating scoring category with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCiteApiUrl(m)+"scoringCategories", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Cite API returned with status code %d when creating scoring category", status)
+	}
+
+	created := &structs.CiteScoringCategory{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCiteScoringCategory wraps the cite API call to read the fields of a scoring category
+//
+// Param id: the id of the scoring category to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scoring category on success
+func ReadCiteScoringCategory(id string, m map[string]string) (*structs.CiteScoringCategory, error) {
+	response, err := getCiteScoringCategoryByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when reading scoring category", status)
+	}
+
+	category := &structs.CiteScoringCategory{}
+	err = json.NewDecoder(response.Body).Decode(category)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read scoring category")
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// UpdateCiteScoringCategory wraps the cite API call to update a scoring category
+//
+// param category: A struct containing the ID of the scoring category and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCiteScoringCategory(category *structs.CiteScoringCategory, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := citeScoringCategoryPayload(category)
+	payload["id"] = category.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringCategories/" + category.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Cite API returned with status code %d when updating scoring category", status)
+	}
+	return nil
+}
+
+// DeleteCiteScoringCategory wraps the cite API call to delete a scoring category
+//
+// Param id: The id of the scoring category to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCiteScoringCategory(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringCategories/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Cite API returned with status code %d when deleting scoring category", status)
+	}
+	return nil
+}
+
+// ListCiteScoringCategories wraps the cite API call to list the scoring categories of a scoring model
+//
+// Param id: The id of the scoring model
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scoring categories on success
+func ListCiteScoringCategories(id string, m map[string]string) ([]structs.CiteScoringCategory, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringModels/" + id + "/scoringCategories"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when listing scoring categories of scoring model %s", status, id)
+	}
+
+	found := []structs.CiteScoringCategory{}
+	err = json.NewDecoder(response.Body).Decode(&found)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list scoring categories")
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// CiteScoringCategoryExists returns whether a scoring category exists along with an error value
+func CiteScoringCategoryExists(id string, m map[string]string) (bool, error) {
+	response, err := getCiteScoringCategoryByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a scoring category by its ID and returns the HTTP response
+func getCiteScoringCategoryByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringCategories/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func citeScoringCategoryPayload(category *structs.CiteScoringCategory) map[string]interface{} {
+	return map[string]interface{}{
+		"scoringModelId":         category.ScoringModelId,
+		"description":            category.Description,
+		"displayOrder":           category.DisplayOrder,
+		"calculationEquation":    category.CalculationEquation,
+		"scoringWeight":          category.ScoringWeight,
+		"isModifierRequired":     category.IsModifierRequired,
+		"scoringOptionSelection": category.ScoringOptionSelection,
+	}
+}
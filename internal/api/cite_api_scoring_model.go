@@ -0,0 +1,214 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCiteScoringModel wraps the create scoring model POST call in cite API
+//
+// param model: A struct containing the fields of the scoring model
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created scoring model and error on failure or nil on success
+func CreateCiteScoringModel(model *structs.CiteScoringModel, m map[string]string) (*structs.CiteScoringModel, error) {
+	log.Printf("! At top of API wrapper to create scoring model")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := citeScoringModelPayload(model)
+
+	log.Printf("! Creating scoring model with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCiteApiUrl(m)+"scoringModels", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Cite API returned with status code %d when creating scoring model", status)
+	}
+
+	created := &structs.CiteScoringModel{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCiteScoringModel wraps the cite API call to read the fields of a scoring model
+//
+// Param id: the id of the scoring model to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scoring model on success
+func ReadCiteScoringModel(id string, m map[string]string) (*structs.CiteScoringModel, error) {
+	response, err := getCiteScoringModelByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when reading scoring model", status)
+	}
+
+	model := &structs.CiteScoringModel{}
+	err = json.NewDecoder(response.Body).Decode(model)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read scoring model")
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// UpdateCiteScoringModel wraps the cite API call to update a scoring model
+//
+// param model: A struct containing the ID of the scoring model and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCiteScoringModel(model *structs.CiteScoringModel, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := citeScoringModelPayload(model)
+	payload["id"] = model.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringModels/" + model.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Cite API returned with status code %d when updating scoring model", status)
+	}
+	return nil
+}
+
+// DeleteCiteScoringModel wraps the cite API call to delete a scoring model
+//
+// Param id: The id of the scoring model to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCiteScoringModel(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringModels/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Cite API returned with status code %d when deleting scoring model", status)
+	}
+	return nil
+}
+
+// CiteScoringModelExists returns whether a scoring model exists along with an error value
+func CiteScoringModelExists(id string, m map[string]string) (bool, error) {
+	response, err := getCiteScoringModelByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a scoring model by its ID and returns the HTTP response
+func getCiteScoringModelByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringModels/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func citeScoringModelPayload(model *structs.CiteScoringModel) map[string]interface{} {
+	return map[string]interface{}{
+		"description":         model.Description,
+		"status":              model.Status,
+		"calculationEquation": model.CalculationEquation,
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCiteScoringOption wraps the create scoring option POST call in cite API
+//
+// param option: A struct containing the fields of the scoring option
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created scoring option and error on failure or nil on success
+func CreateCiteScoringOption(option *structs.CiteScoringOption, m map[string]string) (*structs.CiteScoringOption, error) {
+	log.Printf("! At top of API wrapper to create scoring option")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := citeScoringOptionPayload(option)
+
+	log.Printf("! Creating scoring option with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCiteApiUrl(m)+"scoringOptions", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Cite API returned with status code %d when creating scoring option", status)
+	}
+
+	created := &structs.CiteScoringOption{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCiteScoringOption wraps the cite API call to read the fields of a scoring option
+//
+// Param id: the id of the scoring option to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scoring option on success
+func ReadCiteScoringOption(id string, m map[string]string) (*structs.CiteScoringOption, error) {
+	response, err := getCiteScoringOptionByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when reading scoring option", status)
+	}
+
+	option := &structs.CiteScoringOption{}
+	err = json.NewDecoder(response.Body).Decode(option)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read scoring option")
+		return nil, err
+	}
+
+	return option, nil
+}
+
+// UpdateCiteScoringOption wraps the cite API call to update a scoring option
+//
+// param option: A struct containing the ID of the scoring option and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCiteScoringOption(option *structs.CiteScoringOption, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := citeScoringOptionPayload(option)
+	payload["id"] = option.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringOptions/" + option.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Cite API returned with status code %d when updating scoring option", status)
+	}
+	return nil
+}
+
+// DeleteCiteScoringOption wraps the cite API call to delete a scoring option
+//
+// Param id: The id of the scoring option to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCiteScoringOption(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringOptions/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Cite API returned with status code %d when deleting scoring option", status)
+	}
+	return nil
+}
+
+// ListCiteScoringOptions wraps the cite API call to list the scoring options of a scoring category
+//
+// Param id: The id of the scoring category
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scoring options on success
+func ListCiteScoringOptions(id string, m map[string]string) ([]structs.CiteScoringOption, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringCategories/" + id + "/scoringOptions"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when listing scoring options of scoring category %s", status, id)
+	}
+
+	found := []structs.CiteScoringOption{}
+	err = json.NewDecoder(response.Body).Decode(&found)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list scoring options")
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// CiteScoringOptionExists returns whether a scoring option exists along with an error value
+func CiteScoringOptionExists(id string, m map[string]string) (bool, error) {
+	response, err := getCiteScoringOptionByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a scoring option by its ID and returns the HTTP response
+func getCiteScoringOptionByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "scoringOptions/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func citeScoringOptionPayload(option *structs.CiteScoringOption) map[string]interface{} {
+	return map[string]interface{}{
+		"scoringCategoryId": option.ScoringCategoryId,
+		"description":       option.Description,
+		"displayOrder":      option.DisplayOrder,
+		"value":             option.Value,
+		"isModifier":        option.IsModifier,
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateCiteTeam wraps the create team POST call in cite API
+//
+// param team: A struct containing the fields of the team
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created team and error on failure or nil on success
+func CreateCiteTeam(team *structs.CiteTeam, m map[string]string) (*structs.CiteTeam, error) {
+	log.Printf("! At top of API wrapper to create team")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := citeTeamPayload(team)
+
+	log.Printf("! Creating team with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetCiteApiUrl(m)+"teams", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Cite API returned with status code %d when creating team", status)
+	}
+
+	created := &structs.CiteTeam{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadCiteTeam wraps the cite API call to read the fields of a team
+//
+// Param id: the id of the team to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the team on success
+func ReadCiteTeam(id string, m map[string]string) (*structs.CiteTeam, error) {
+	response, err := getCiteTeamByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when reading team", status)
+	}
+
+	team := &structs.CiteTeam{}
+	err = json.NewDecoder(response.Body).Decode(team)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read team")
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// UpdateCiteTeam wraps the cite API call to update a team
+//
+// param team: A struct containing the ID of the team and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateCiteTeam(team *structs.CiteTeam, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := citeTeamPayload(team)
+	payload["id"] = team.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "teams/" + team.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Cite API returned with status code %d when updating team", status)
+	}
+	return nil
+}
+
+// DeleteCiteTeam wraps the cite API call to delete a team
+//
+// Param id: The id of the team to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteCiteTeam(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetCiteApiUrl(m) + "teams/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Cite API returned with status code %d when deleting team", status)
+	}
+	return nil
+}
+
+// ListCiteTeams wraps the cite API call to list the teams of an evaluation
+//
+// Param id: The id of the evaluation
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the teams on success
+func ListCiteTeams(id string, m map[string]string) ([]structs.CiteTeam, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "evaluations/" + id + "/teams"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Cite API returned with status code %d when listing teams of evaluation %s", status, id)
+	}
+
+	found := []structs.CiteTeam{}
+	err = json.NewDecoder(response.Body).Decode(&found)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list teams")
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// CiteTeamExists returns whether a team exists along with an error value
+func CiteTeamExists(id string, m map[string]string) (bool, error) {
+	response, err := getCiteTeamByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a team by its ID and returns the HTTP response
+func getCiteTeamByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetCiteApiUrl(m) + "teams/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. CITE expects null rather than an empty string for IDs that aren't set.
+func citeTeamPayload(team *structs.CiteTeam) map[string]interface{} {
+	payload := map[string]interface{}{
+		"evaluationId": team.EvaluationId,
+		"name":         team.Name,
+		"shortName":    team.ShortName,
+		"teamTypeId":   nil,
+	}
+
+	if team.TeamTypeId != "" {
+		payload["teamTypeId"] = team.TeamTypeId
+	}
+	return payload
+}
@@ -0,0 +1,236 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGalleryArticle wraps the create article POST call in gallery API
+//
+// param article: A struct containing the fields of the article
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created article and error on failure or nil on success
+func CreateGalleryArticle(article *structs.GalleryArticle, m map[string]string) (*structs.GalleryArticle, error) {
+	log.Printf("! At top of API wrapper to create article")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := galleryArticlePayload(article)
+
+	log.Printf("! Creating article with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGalleryApiUrl(m)+"articles", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when creating article", status)
+	}
+
+	created := &structs.GalleryArticle{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGalleryArticle wraps the gallery API call to read the fields of an article
+//
+// Param id: the id of the article to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the article on success
+func ReadGalleryArticle(id string, m map[string]string) (*structs.GalleryArticle, error) {
+	response, err := getGalleryArticleByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when reading article", status)
+	}
+
+	article := &structs.GalleryArticle{}
+	err = json.NewDecoder(response.Body).Decode(article)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read article")
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// UpdateGalleryArticle wraps the gallery API call to update an article
+//
+// param article: A struct containing the ID of the article and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGalleryArticle(article *structs.GalleryArticle, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := galleryArticlePayload(article)
+	payload["id"] = article.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "articles/" + article.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gallery API returned with status code %d when updating article", status)
+	}
+	return nil
+}
+
+// DeleteGalleryArticle wraps the gallery API call to delete an article
+//
+// Param id: The id of the article to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGalleryArticle(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "articles/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Gallery API returned with status code %d when deleting article", status)
+	}
+	return nil
+}
+
+// GalleryArticleExists returns whether an article exists along with an error value
+func GalleryArticleExists(id string, m map[string]string) (bool, error) {
+	response, err := getGalleryArticleByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets an article by its ID and returns the HTTP response
+func getGalleryArticleByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "articles/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. Gallery expects null rather than an empty string for IDs and times
+// that aren't set.
+func galleryArticlePayload(article *structs.GalleryArticle) map[string]interface{} {
+	payload := map[string]interface{}{
+		"collectionId": article.CollectionId,
+		"exhibitId":    nil,
+		"cardId":       nil,
+		"name":         article.Name,
+		"summary":      article.Summary,
+		"description":  article.Description,
+		"move":         article.Move,
+		"inject":       article.Inject,
+		"sourceType":   article.SourceType,
+		"sourceName":   article.SourceName,
+		"url":          article.Url,
+		"datePosted":   nil,
+		"openInNewTab": article.OpenInNewTab,
+	}
+
+	if article.ExhibitId != "" {
+		payload["exhibitId"] = article.ExhibitId
+	}
+	if article.CardId != "" {
+		payload["cardId"] = article.CardId
+	}
+	if article.DatePosted != "" {
+		payload["datePosted"] = article.DatePosted
+	}
+	return payload
+}
@@ -0,0 +1,216 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGalleryCard wraps the create card POST call in gallery API
+//
+// param card: A struct containing the fields of the card
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created card and error on failure or nil on success
+func CreateGalleryCard(card *structs.GalleryCard, m map[string]string) (*structs.GalleryCard, error) {
+	log.Printf("! At top of API wrapper to create card")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := galleryCardPayload(card)
+
+	log.Printf("! Creating card with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGalleryApiUrl(m)+"cards", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when creating card", status)
+	}
+
+	created := &structs.GalleryCard{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGalleryCard wraps the gallery API call to read the fields of a card
+//
+// Param id: the id of the card to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the card on success
+func ReadGalleryCard(id string, m map[string]string) (*structs.GalleryCard, error) {
+	response, err := getGalleryCardByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when reading card", status)
+	}
+
+	card := &structs.GalleryCard{}
+	err = json.NewDecoder(response.Body).Decode(card)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read card")
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// UpdateGalleryCard wraps the gallery API call to update a card
+//
+// param card: A struct containing the ID of the card and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGalleryCard(card *structs.GalleryCard, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := galleryCardPayload(card)
+	payload["id"] = card.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "cards/" + card.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gallery API returned with status code %d when updating card", status)
+	}
+	return nil
+}
+
+// DeleteGalleryCard wraps the gallery API call to delete a card
+//
+// Param id: The id of the card to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGalleryCard(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "cards/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Gallery API returned with status code %d when deleting card", status)
+	}
+	return nil
+}
+
+// GalleryCardExists returns whether a card exists along with an error value
+func GalleryCardExists(id string, m map[string]string) (bool, error) {
+	response, err := getGalleryCardByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a card by its ID and returns the HTTP response
+func getGalleryCardByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "cards/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func galleryCardPayload(card *structs.GalleryCard) map[string]interface{} {
+	return map[string]interface{}{
+		"collectionId": card.CollectionId,
+		"name":         card.Name,
+		"description":  card.Description,
+		"move":         card.Move,
+		"inject":       card.Inject,
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGalleryCollection wraps the create collection POST call in gallery API
+//
+// param collection: A struct containing the fields of the collection
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created collection and error on failure or nil on success
+func CreateGalleryCollection(collection *structs.GalleryCollection, m map[string]string) (*structs.GalleryCollection, error) {
+	log.Printf("! At top of API wrapper to create collection")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := galleryCollectionPayload(collection)
+
+	log.Printf("! Creating collection with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGalleryApiUrl(m)+"collections", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when creating collection", status)
+	}
+
+	created := &structs.GalleryCollection{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGalleryCollection wraps the gallery API call to read the fields of a collection
+//
+// Param id: the id of the collection to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the collection on success
+func ReadGalleryCollection(id string, m map[string]string) (*structs.GalleryCollection, error) {
+	response, err := getGalleryCollectionByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when reading collection", status)
+	}
+
+	collection := &structs.GalleryCollection{}
+	err = json.NewDecoder(response.Body).Decode(collection)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read collection")
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// UpdateGalleryCollection wraps the gallery API call to update a collection
+//
+// param collection: A struct containing the ID of the collection and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGalleryCollection(collection *structs.GalleryCollection, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := galleryCollectionPayload(collection)
+	payload["id"] = collection.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "collections/" + collection.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gallery API returned with status code %d when updating collection", status)
+	}
+	return nil
+}
+
+// DeleteGalleryCollection wraps the gallery API call to delete a collection
+//
+// Param id: The id of the collection to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGalleryCollection(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "collections/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Gallery API returned with status code %d when deleting collection", status)
+	}
+	return nil
+}
+
+// GalleryCollectionExists returns whether a collection exists along with an error value
+func GalleryCollectionExists(id string, m map[string]string) (bool, error) {
+	response, err := getGalleryCollectionByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a collection by its ID and returns the HTTP response
+func getGalleryCollectionByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "collections/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func galleryCollectionPayload(collection *structs.GalleryCollection) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        collection.Name,
+		"description": collection.Description,
+	}
+}
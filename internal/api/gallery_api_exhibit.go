@@ -0,0 +1,221 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGalleryExhibit wraps the create exhibit POST call in gallery API
+//
+// param exhibit: A struct containing the fields of the exhibit
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created exhibit and error on failure or nil on success
+func CreateGalleryExhibit(exhibit *structs.GalleryExhibit, m map[string]string) (*structs.GalleryExhibit, error) {
+	log.Printf("! At top of API wrapper to create exhibit")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := galleryExhibitPayload(exhibit)
+
+	log.Printf("! Creating exhibit with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGalleryApiUrl(m)+"exhibits", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when creating exhibit", status)
+	}
+
+	created := &structs.GalleryExhibit{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGalleryExhibit wraps the gallery API call to read the fields of an exhibit
+//
+// Param id: the id of the exhibit to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the exhibit on success
+func ReadGalleryExhibit(id string, m map[string]string) (*structs.GalleryExhibit, error) {
+	response, err := getGalleryExhibitByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when reading exhibit", status)
+	}
+
+	exhibit := &structs.GalleryExhibit{}
+	err = json.NewDecoder(response.Body).Decode(exhibit)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read exhibit")
+		return nil, err
+	}
+
+	return exhibit, nil
+}
+
+// UpdateGalleryExhibit wraps the gallery API call to update an exhibit
+//
+// param exhibit: A struct containing the ID of the exhibit and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGalleryExhibit(exhibit *structs.GalleryExhibit, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := galleryExhibitPayload(exhibit)
+	payload["id"] = exhibit.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "exhibits/" + exhibit.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gallery API returned with status code %d when updating exhibit", status)
+	}
+	return nil
+}
+
+// DeleteGalleryExhibit wraps the gallery API call to delete an exhibit
+//
+// Param id: The id of the exhibit to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGalleryExhibit(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "exhibits/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Gallery API returned with status code %d when deleting exhibit", status)
+	}
+	return nil
+}
+
+// GalleryExhibitExists returns whether an exhibit exists along with an error value
+func GalleryExhibitExists(id string, m map[string]string) (bool, error) {
+	response, err := getGalleryExhibitByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets an exhibit by its ID and returns the HTTP response
+func getGalleryExhibitByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "exhibits/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. Gallery expects null rather than an empty string for IDs that aren't
+// set.
+func galleryExhibitPayload(exhibit *structs.GalleryExhibit) map[string]interface{} {
+	payload := map[string]interface{}{
+		"collectionId":  exhibit.CollectionId,
+		"scenarioId":    nil,
+		"currentMove":   exhibit.CurrentMove,
+		"currentInject": exhibit.CurrentInject,
+	}
+
+	if exhibit.ScenarioId != "" {
+		payload["scenarioId"] = exhibit.ScenarioId
+	}
+	return payload
+}
@@ -0,0 +1,256 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGalleryTeam wraps the create team POST call in gallery API
+//
+// param team: A struct containing the fields of the team
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created team and error on failure or nil on success
+func CreateGalleryTeam(team *structs.GalleryTeam, m map[string]string) (*structs.GalleryTeam, error) {
+	log.Printf("! At top of API wrapper to create team")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := galleryTeamPayload(team)
+
+	log.Printf("! Creating team with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGalleryApiUrl(m)+"teams", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when creating team", status)
+	}
+
+	created := &structs.GalleryTeam{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGalleryTeam wraps the gallery API call to read the fields of a team
+//
+// Param id: the id of the team to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the team on success
+func ReadGalleryTeam(id string, m map[string]string) (*structs.GalleryTeam, error) {
+	response, err := getGalleryTeamByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when reading team", status)
+	}
+
+	team := &structs.GalleryTeam{}
+	err = json.NewDecoder(response.Body).Decode(team)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read team")
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// UpdateGalleryTeam wraps the gallery API call to update a team
+//
+// param team: A struct containing the ID of the team and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGalleryTeam(team *structs.GalleryTeam, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := galleryTeamPayload(team)
+	payload["id"] = team.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "teams/" + team.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gallery API returned with status code %d when updating team", status)
+	}
+	return nil
+}
+
+// DeleteGalleryTeam wraps the gallery API call to delete a team
+//
+// Param id: The id of the team to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGalleryTeam(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "teams/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Gallery API returned with status code %d when deleting team", status)
+	}
+	return nil
+}
+
+// ListGalleryTeams wraps the gallery API call to list the teams of an exhibit
+//
+// Param id: The id of the exhibit
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the teams on success
+func ListGalleryTeams(id string, m map[string]string) ([]structs.GalleryTeam, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "exhibits/" + id + "/teams"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when listing teams of exhibit %s", status, id)
+	}
+
+	found := []structs.GalleryTeam{}
+	err = json.NewDecoder(response.Body).Decode(&found)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list teams")
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// GalleryTeamExists returns whether a team exists along with an error value
+func GalleryTeamExists(id string, m map[string]string) (bool, error) {
+	response, err := getGalleryTeamByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a team by its ID and returns the HTTP response
+func getGalleryTeamByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "teams/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func galleryTeamPayload(team *structs.GalleryTeam) map[string]interface{} {
+	return map[string]interface{}{
+		"exhibitId": team.ExhibitId,
+		"name":      team.Name,
+		"shortName": team.ShortName,
+	}
+}
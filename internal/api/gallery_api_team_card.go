@@ -0,0 +1,259 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGalleryTeamCard wraps the create team card POST call in gallery API
+//
+// param teamCard: A struct containing the fields of the team card
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created team card and error on failure or nil on success
+func CreateGalleryTeamCard(teamCard *structs.GalleryTeamCard, m map[string]string) (*structs.GalleryTeamCard, error) {
+	log.Printf("! At top of API wrapper to create team card")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := galleryTeamCardPayload(teamCard)
+
+	log.Printf("! Creating team card with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGalleryApiUrl(m)+"teamCards", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when creating team card", status)
+	}
+
+	created := &structs.GalleryTeamCard{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGalleryTeamCard wraps the gallery API call to read the fields of a team card
+//
+// Param id: the id of the team card to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the team card on success
+func ReadGalleryTeamCard(id string, m map[string]string) (*structs.GalleryTeamCard, error) {
+	response, err := getGalleryTeamCardByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when reading team card", status)
+	}
+
+	teamCard := &structs.GalleryTeamCard{}
+	err = json.NewDecoder(response.Body).Decode(teamCard)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read team card")
+		return nil, err
+	}
+
+	return teamCard, nil
+}
+
+// UpdateGalleryTeamCard wraps the gallery API call to update a team card
+//
+// param teamCard: A struct containing the ID of the team card and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGalleryTeamCard(teamCard *structs.GalleryTeamCard, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := galleryTeamCardPayload(teamCard)
+	payload["id"] = teamCard.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "teamCards/" + teamCard.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gallery API returned with status code %d when updating team card", status)
+	}
+	return nil
+}
+
+// DeleteGalleryTeamCard wraps the gallery API call to delete a team card
+//
+// Param id: The id of the team card to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGalleryTeamCard(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "teamCards/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Gallery API returned with status code %d when deleting team card", status)
+	}
+	return nil
+}
+
+// ListGalleryTeamCards wraps the gallery API call to list the team cards of a card
+//
+// Param id: The id of the card
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the team cards on success
+func ListGalleryTeamCards(id string, m map[string]string) ([]structs.GalleryTeamCard, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "cards/" + id + "/teamCards"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gallery API returned with status code %d when listing team cards of card %s", status, id)
+	}
+
+	found := []structs.GalleryTeamCard{}
+	err = json.NewDecoder(response.Body).Decode(&found)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list team cards")
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// GalleryTeamCardExists returns whether a team card exists along with an error value
+func GalleryTeamCardExists(id string, m map[string]string) (bool, error) {
+	response, err := getGalleryTeamCardByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a team card by its ID and returns the HTTP response
+func getGalleryTeamCardByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGalleryApiUrl(m) + "teamCards/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func galleryTeamCardPayload(teamCard *structs.GalleryTeamCard) map[string]interface{} {
+	return map[string]interface{}{
+		"teamId":          teamCard.TeamId,
+		"cardId":          teamCard.CardId,
+		"move":            teamCard.Move,
+		"inject":          teamCard.Inject,
+		"isShownOnWall":   teamCard.IsShownOnWall,
+		"canPostArticles": teamCard.CanPostArticles,
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGameboardChallengeSpec wraps the create challenge spec POST call in Gameboard API
+//
+// param spec: A struct containing the fields of the challenge spec
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created challenge spec and error on failure or nil on success
+func CreateGameboardChallengeSpec(spec *structs.GameboardChallengeSpec, m map[string]string) (*structs.GameboardChallengeSpec, error) {
+	log.Printf("! At top of API wrapper to create challenge spec")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := gameboardChallengeSpecPayload(spec)
+
+	log.Printf("! Creating challenge spec with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGameboardApiUrl(m)+"challengespec", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gameboard API returned with status code %d when creating challenge spec", status)
+	}
+
+	created := &structs.GameboardChallengeSpec{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGameboardChallengeSpec wraps the Gameboard API call to read the fields of a challenge spec
+//
+// Param id: the id of the challenge spec to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the challenge spec on success
+func ReadGameboardChallengeSpec(id string, m map[string]string) (*structs.GameboardChallengeSpec, error) {
+	response, err := getGameboardChallengeSpecByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gameboard API returned with status code %d when reading challenge spec", status)
+	}
+
+	spec := &structs.GameboardChallengeSpec{}
+	err = json.NewDecoder(response.Body).Decode(spec)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read challenge spec")
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// UpdateGameboardChallengeSpec wraps the Gameboard API call to update a challenge spec
+//
+// param spec: A struct containing the ID of the challenge spec and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGameboardChallengeSpec(spec *structs.GameboardChallengeSpec, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := gameboardChallengeSpecPayload(spec)
+	payload["id"] = spec.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGameboardApiUrl(m) + "challengespec"
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gameboard API returned with status code %d when updating challenge spec", status)
+	}
+	return nil
+}
+
+// DeleteGameboardChallengeSpec wraps the Gameboard API call to delete a challenge spec
+//
+// Param id: The id of the challenge spec to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGameboardChallengeSpec(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGameboardApiUrl(m) + "challengespec/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gameboard API returned with status code %d when deleting challenge spec", status)
+	}
+	return nil
+}
+
+// GameboardChallengeSpecExists returns whether a challenge spec exists along with an error value
+func GameboardChallengeSpecExists(id string, m map[string]string) (bool, error) {
+	response, err := getGameboardChallengeSpecByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a challenge spec by its ID and returns the HTTP response
+func getGameboardChallengeSpecByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGameboardApiUrl(m) + "challengespec/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func gameboardChallengeSpecPayload(spec *structs.GameboardChallengeSpec) map[string]interface{} {
+	return map[string]interface{}{
+		"gameId":         spec.GameId,
+		"externalId":     spec.ExternalId,
+		"gameEngineType": spec.GameEngineType,
+		"name":           spec.Name,
+		"description":    spec.Description,
+		"tag":            spec.Tag,
+		"points":         spec.Points,
+		"disabled":       spec.Disabled,
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateGameboardGame wraps the create game POST call in Gameboard API
+//
+// param game: A struct containing the fields of the game
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created game and error on failure or nil on success
+func CreateGameboardGame(game *structs.GameboardGame, m map[string]string) (*structs.GameboardGame, error) {
+	log.Printf("! At top of API wrapper to create game")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := gameboardGamePayload(game)
+
+	log.Printf("! Creating game with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetGameboardApiUrl(m)+"game", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gameboard API returned with status code %d when creating game", status)
+	}
+
+	created := &structs.GameboardGame{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadGameboardGame wraps the Gameboard API call to read the fields of a game
+//
+// Param id: the id of the game to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the game on success
+func ReadGameboardGame(id string, m map[string]string) (*structs.GameboardGame, error) {
+	response, err := getGameboardGameByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Gameboard API returned with status code %d when reading game", status)
+	}
+
+	game := &structs.GameboardGame{}
+	err = json.NewDecoder(response.Body).Decode(game)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read game")
+		return nil, err
+	}
+
+	return game, nil
+}
+
+// UpdateGameboardGame wraps the Gameboard API call to update a game
+//
+// param game: A struct containing the ID of the game and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateGameboardGame(game *structs.GameboardGame, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := gameboardGamePayload(game)
+	payload["id"] = game.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGameboardApiUrl(m) + "game"
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gameboard API returned with status code %d when updating game", status)
+	}
+	return nil
+}
+
+// DeleteGameboardGame wraps the Gameboard API call to delete a game
+//
+// Param id: The id of the game to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteGameboardGame(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetGameboardApiUrl(m) + "game/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Gameboard API returned with status code %d when deleting game", status)
+	}
+	return nil
+}
+
+// GameboardGameExists returns whether a game exists along with an error value
+func GameboardGameExists(id string, m map[string]string) (bool, error) {
+	response, err := getGameboardGameByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a game by its ID and returns the HTTP response
+func getGameboardGameByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetGameboardApiUrl(m) + "game/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. Times that are not set are left out so Gameboard keeps them unset.
+func gameboardGamePayload(game *structs.GameboardGame) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":                     game.Name,
+		"competition":              game.Competition,
+		"season":                   game.Season,
+		"track":                    game.Track,
+		"division":                 game.Division,
+		"registrationType":         game.RegistrationType,
+		"minTeamSize":              game.MinTeamSize,
+		"maxTeamSize":              game.MaxTeamSize,
+		"sessionMinutes":           game.SessionMinutes,
+		"sessionLimit":             game.SessionLimit,
+		"gamespaceLimitPerSession": game.GamespaceLimitPerSession,
+		"maxAttempts":              game.MaxAttempts,
+		"isPublished":              game.IsPublished,
+	}
+
+	if game.GameStart != "" {
+		payload["gameStart"] = game.GameStart
+	}
+	if game.GameEnd != "" {
+		payload["gameEnd"] = game.GameEnd
+	}
+	if game.RegistrationOpen != "" {
+		payload["registrationOpen"] = game.RegistrationOpen
+	}
+	if game.RegistrationClose != "" {
+		payload["registrationClose"] = game.RegistrationClose
+	}
+	return payload
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateIdentityAccount wraps the create account POST call in Identity API
+//
+// param account: A struct containing the fields of the account
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created account and error on failure or nil on success
+func CreateIdentityAccount(account *structs.IdentityAccount, m map[string]string) (*structs.IdentityAccount, error) {
+	log.Printf("! At top of API wrapper to create account")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := identityAccountPayload(account)
+
+	log.Printf("! Creating account with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetIdentityApiUrl(m)+"account", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Identity API returned with status code %d when creating account", status)
+	}
+
+	created := &structs.IdentityAccount{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadIdentityAccount wraps the Identity API call to read the fields of an account
+//
+// Param id: the id of the account to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the account on success
+func ReadIdentityAccount(id string, m map[string]string) (*structs.IdentityAccount, error) {
+	response, err := getIdentityAccountByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Identity API returned with status code %d when reading account", status)
+	}
+
+	account := &structs.IdentityAccount{}
+	err = json.NewDecoder(response.Body).Decode(account)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read account")
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// UpdateIdentityAccount wraps the Identity API call to update an account
+//
+// param account: A struct containing the ID of the account and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateIdentityAccount(account *structs.IdentityAccount, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := identityAccountPayload(account)
+	payload["id"] = account.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "account/" + strconv.Itoa(account.Id)
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Identity API returned with status code %d when updating account", status)
+	}
+	return nil
+}
+
+// DeleteIdentityAccount wraps the Identity API call to delete an account
+//
+// Param id: The id of the account to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteIdentityAccount(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "account/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Identity API returned with status code %d when deleting account", status)
+	}
+	return nil
+}
+
+// IdentityAccountExists returns whether an account exists along with an error value
+func IdentityAccountExists(id string, m map[string]string) (bool, error) {
+	response, err := getIdentityAccountByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets an account by its ID and returns the HTTP response
+func getIdentityAccountByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "account/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. The password is left out when it is not set so an update keeps the
+// account's current password.
+func identityAccountPayload(account *structs.IdentityAccount) map[string]interface{} {
+	payload := map[string]interface{}{
+		"username":    account.Username,
+		"displayName": account.DisplayName,
+		"role":        account.Role,
+		"status":      account.Status,
+	}
+
+	if account.Password != "" {
+		payload["password"] = account.Password
+	}
+	return payload
+}
@@ -0,0 +1,261 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateIdentityClient wraps the create client POST call in Identity API
+//
+// param identityClient: A struct containing the fields of the client
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created client and error on failure or nil on success
+func CreateIdentityClient(identityClient *structs.IdentityClient, m map[string]string) (*structs.IdentityClient, error) {
+	log.Printf("! At top of API wrapper to create client")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := identityClientPayload(identityClient)
+
+	log.Printf("! Creating client with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetIdentityApiUrl(m)+"client", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Identity API returned with status code %d when creating client", status)
+	}
+
+	created := &structs.IdentityClient{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadIdentityClient wraps the Identity API call to read the fields of a client
+//
+// Param id: the id of the client to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the client on success
+func ReadIdentityClient(id string, m map[string]string) (*structs.IdentityClient, error) {
+	response, err := getIdentityClientByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Identity API returned with status code %d when reading client", status)
+	}
+
+	identityClient := &structs.IdentityClient{}
+	err = json.NewDecoder(response.Body).Decode(identityClient)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read client")
+		return nil, err
+	}
+
+	return identityClient, nil
+}
+
+// UpdateIdentityClient wraps the Identity API call to update a client
+//
+// param identityClient: A struct containing the ID of the client and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateIdentityClient(identityClient *structs.IdentityClient, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := identityClientPayload(identityClient)
+	payload["id"] = identityClient.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "client/" + strconv.Itoa(identityClient.Id)
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Identity API returned with status code %d when updating client", status)
+	}
+	return nil
+}
+
+// DeleteIdentityClient wraps the Identity API call to delete a client
+//
+// Param id: The id of the client to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteIdentityClient(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "client/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Identity API returned with status code %d when deleting client", status)
+	}
+	return nil
+}
+
+// NewIdentityClientSecret wraps the Identity API call to generate a new secret for a client. The identity server
+// only returns the value of a secret when it is generated.
+//
+// param id: The id of the client
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the secret and error on failure or nil on success
+func NewIdentityClientSecret(id string, m map[string]string) (string, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return "", err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "client/" + id + "/secret"
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return "", fmt.Errorf("Identity API returned with status code %d when generating client secret", status)
+	}
+
+	var secret string
+	err = json.NewDecoder(response.Body).Decode(&secret)
+	if err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// IdentityClientExists returns whether a client exists along with an error value
+func IdentityClientExists(id string, m map[string]string) (bool, error) {
+	response, err := getIdentityClientByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a client by its ID and returns the HTTP response
+func getIdentityClientByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetIdentityApiUrl(m) + "client/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func identityClientPayload(identityClient *structs.IdentityClient) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         identityClient.Name,
+		"displayName":  identityClient.DisplayName,
+		"description":  identityClient.Description,
+		"grants":       identityClient.Grants,
+		"scopes":       identityClient.Scopes,
+		"redirectUrls": identityClient.RedirectUrls,
+		"enabled":      identityClient.Enabled,
+	}
+}
@@ -39,7 +39,7 @@ func CreateApps(apps *[]*structs.AppInfo, m map[string]string, viewID string) er
 
 		url := util.GetPlayerApiUrl(m) + "views/" + viewID + "/applications"
 		log.Printf("! creating app. url: %v", url)
-		log.Printf("! Payload: %+v", app)
+		log.Printf("! Payload: %s", util.Redact(app))
 		request, err := http.NewRequest("POST", url, bytes.NewBuffer(asJSON))
 		if err != nil {
 			return err
@@ -52,7 +52,7 @@ func CreateApps(apps *[]*structs.AppInfo, m map[string]string, viewID string) er
 		if err != nil {
 			return err
 		}
-		log.Printf("! Response: %v", response)
+		log.Printf("! Response: %s", util.Redact(response))
 
 		status := response.StatusCode
 		if status != http.StatusCreated {
@@ -170,7 +170,7 @@ func UpdateAppInstance(inst structs.AppInstance, teamID string, m map[string]str
 	payload["displayOrder"] = inst.DisplayOrder
 	payload["applicationId"] = inst.Parent
 
-	log.Printf("! Updating app instance with payload %+v", payload)
+	log.Printf("! Updating app instance with payload %s", util.Redact(payload))
 
 	asJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -186,14 +186,14 @@ func UpdateAppInstance(inst structs.AppInstance, teamID string, m map[string]str
 	request.Header.Set("Content-Type", "application/json")
 	client := &http.Client{}
 
-	log.Printf("! Request: %+v", request)
+	log.Printf("! Request: %s", util.Redact(request))
 
 	response, err := client.Do(request)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("! Response: %+v", response)
+	log.Printf("! Response: %s", util.Redact(response))
 
 	status := response.StatusCode
 	if status != http.StatusOK {
@@ -287,14 +287,14 @@ func DeleteAppInstances(toDelete *[]string, m map[string]string) error {
 		request.Header.Add("Authorization", "Bearer "+auth)
 		client := &http.Client{}
 
-		log.Printf("! Request: %+v", request)
+		log.Printf("! Request: %s", util.Redact(request))
 
 		response, err := client.Do(request)
 		if err != nil {
 			return err
 		}
 
-		log.Printf("! Response: %+v", response)
+		log.Printf("! Response: %s", util.Redact(response))
 
 		status := response.StatusCode
 		if status != http.StatusNoContent {
@@ -42,7 +42,7 @@ func CreateAppTemplate(template *structs.AppTemplate, m map[string]string) (stri
 		return "", err
 	}
 
-	log.Printf("! Creating template with payload %+v", payload)
+	log.Printf("! Creating template with payload %s", util.Redact(payload))
 	// Create the template
 	url := util.GetPlayerApiUrl(m) + "application-templates"
 	request, err := http.NewRequest("POST", url, bytes.NewBuffer(asJSON))
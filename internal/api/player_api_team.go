@@ -56,7 +56,7 @@ func CreateTeams(teams *[]*structs.TeamInfo, viewID string, m map[string]string)
 			return err
 		}
 
-		log.Printf("! Team being created: %+v", asMap)
+		log.Printf("! Team being created: %s", util.Redact(asMap))
 
 		url := util.GetPlayerApiUrl(m) + "views/" + viewID + "/teams"
 		request, err := http.NewRequest("POST", url, bytes.NewBuffer(asJSON))
@@ -86,7 +86,7 @@ func CreateTeams(teams *[]*structs.TeamInfo, viewID string, m map[string]string)
 		teamID := body["id"].(string)
 		(*teams)[i].ID = teamID
 
-		log.Printf("! Team creation response body: %+v", body)
+		log.Printf("! Team creation response body: %s", util.Redact(body))
 		// Add each user to this team
 		for _, user := range team.Users {
 			err := addUser(user.ID, teamID, m)
@@ -149,7 +149,7 @@ func UpdateTeams(teams *[]*structs.TeamInfo, m map[string]string) error {
 
 		url := util.GetPlayerApiUrl(m) + "teams/" + team.ID.(string)
 		log.Printf("! Updating team. URL: %v", url)
-		log.Printf("! Updating team. Payload: %+v", team)
+		log.Printf("! Updating team. Payload: %s", util.Redact(team))
 		request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
 		if err != nil {
 			return err
@@ -401,7 +401,7 @@ func readTeams(viewID string, m map[string]string) (*[]structs.TeamInfo, error)
 		return nil, err
 	}
 
-	log.Printf("! Remote team state as map: %+v", asMap)
+	log.Printf("! Remote team state as map: %s", util.Redact(asMap))
 	for _, team := range *asMap {
 		permissions := new([]string)
 		permissionsMaps := team["permissions"].([]interface{})
@@ -444,7 +444,7 @@ func readTeams(viewID string, m map[string]string) (*[]structs.TeamInfo, error)
 		(*teams)[i] = team
 	}
 
-	log.Printf("! Returning from api, team structs are: %+v", teams)
+	log.Printf("! Returning from api, team structs are: %s", util.Redact(teams))
 	return teams, nil
 }
 
@@ -38,7 +38,7 @@ func CreateView(view *structs.ViewInfo, m map[string]string) (string, error) {
 		"createAdminTeam": view.CreateAdminTeam,
 	}
 
-	log.Printf("! Creating view with payload %+v", payload)
+	log.Printf("! Creating view with payload %s", util.Redact(payload))
 
 	asJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -156,12 +156,12 @@ func UpdateView(view *structs.ViewInfo, m map[string]string, id string) error {
 	request.Header.Set("Content-Type", "application/json")
 	client := &http.Client{}
 
-	log.Printf("! View before update api call %+v", asMap)
+	log.Printf("! View before update api call %s", util.Redact(asMap))
 	response, err := client.Do(request)
 	if err != nil {
 		return err
 	}
-	log.Printf("! Response: %+v", response)
+	log.Printf("! Response: %s", util.Redact(response))
 
 	status := response.StatusCode
 	if status != http.StatusOK {
@@ -0,0 +1,299 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateSteamfitterScenario wraps the steamfitter API call that creates a scenario from a scenario template, copying
+// the template's tasks into it
+//
+// param templateID: The id of the scenario template to create the scenario from
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created scenario and error on failure or nil on success
+func CreateSteamfitterScenario(templateID string, m map[string]string) (*structs.SteamfitterScenario, error) {
+	log.Printf("! At top of API wrapper to create scenario")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarioTemplates/" + templateID + "/scenarios"
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when creating scenario from template %s", status, templateID)
+	}
+
+	created := &structs.SteamfitterScenario{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadSteamfitterScenario wraps the steamfitter API call to read the fields of a scenario
+//
+// Param id: the id of the scenario to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scenario on success
+func ReadSteamfitterScenario(id string, m map[string]string) (*structs.SteamfitterScenario, error) {
+	response, err := getSteamfitterScenarioByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when reading scenario", status)
+	}
+
+	scenario := &structs.SteamfitterScenario{}
+	err = json.NewDecoder(response.Body).Decode(scenario)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read scenario")
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+// UpdateSteamfitterScenario wraps the steamfitter API call to update a scenario
+//
+// param scenario: A struct containing the ID of the scenario and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateSteamfitterScenario(scenario *structs.SteamfitterScenario, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := steamfitterScenarioPayload(scenario)
+	payload["id"] = scenario.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarios/" + scenario.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Steamfitter API returned with status code %d when updating scenario", status)
+	}
+	return nil
+}
+
+// DeleteSteamfitterScenario wraps the steamfitter API call to delete a scenario
+//
+// Param id: The id of the scenario to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteSteamfitterScenario(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarios/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Steamfitter API returned with status code %d when deleting scenario", status)
+	}
+	return nil
+}
+
+// StartSteamfitterScenario wraps the steamfitter API call that starts a scenario, so its tasks begin to run
+//
+// Param id: The id of the scenario to start
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func StartSteamfitterScenario(id string, m map[string]string) error {
+	return changeSteamfitterScenarioState(id, "start", m)
+}
+
+// EndSteamfitterScenario wraps the steamfitter API call that ends a scenario. Its tasks and their results are kept.
+//
+// Param id: The id of the scenario to end
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func EndSteamfitterScenario(id string, m map[string]string) error {
+	return changeSteamfitterScenarioState(id, "end", m)
+}
+
+// ListSteamfitterScenarioResults wraps the steamfitter API call to list the results of the tasks run in a scenario
+//
+// Param id: The id of the scenario
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the results on success
+func ListSteamfitterScenarioResults(id string, m map[string]string) ([]structs.SteamfitterResult, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarios/" + id + "/results"
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when listing results of scenario %s", status, id)
+	}
+
+	results := []structs.SteamfitterResult{}
+	err = json.NewDecoder(response.Body).Decode(&results)
+	if err != nil {
+		log.Printf("! Error unmarshaling in list scenario results")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// SteamfitterScenarioExists returns whether a scenario exists along with an error value
+func SteamfitterScenarioExists(id string, m map[string]string) (bool, error) {
+	response, err := getSteamfitterScenarioByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a scenario by its ID and returns the HTTP response
+func getSteamfitterScenarioByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarios/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of an update call
+func steamfitterScenarioPayload(scenario *structs.SteamfitterScenario) map[string]interface{} {
+	return map[string]interface{}{
+		"name":               scenario.Name,
+		"description":        scenario.Description,
+		"scenarioTemplateId": scenario.ScenarioTemplateId,
+		"viewId":             scenario.ViewId,
+	}
+}
+
+// Starts or ends a scenario
+func changeSteamfitterScenarioState(id, action string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarios/" + id + "/" + action
+	request, err := http.NewRequest("PUT", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Steamfitter API returned with status code %d when trying to %s scenario %s", status, action, id)
+	}
+	return nil
+}
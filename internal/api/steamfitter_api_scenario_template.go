@@ -0,0 +1,214 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateSteamfitterScenarioTemplate wraps the create scenario template POST call in steamfitter API
+//
+// param template: A struct containing the fields of the scenario template
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created scenario template and error on failure or nil on success
+func CreateSteamfitterScenarioTemplate(template *structs.SteamfitterScenarioTemplate, m map[string]string) (*structs.SteamfitterScenarioTemplate, error) {
+	log.Printf("! At top of API wrapper to create scenario template")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := steamfitterScenarioTemplatePayload(template)
+
+	log.Printf("! Creating scenario template with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetSteamfitterApiUrl(m)+"scenarioTemplates", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when creating scenario template", status)
+	}
+
+	created := &structs.SteamfitterScenarioTemplate{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadSteamfitterScenarioTemplate wraps the steamfitter API call to read the fields of a scenario template
+//
+// Param id: the id of the scenario template to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the scenario template on success
+func ReadSteamfitterScenarioTemplate(id string, m map[string]string) (*structs.SteamfitterScenarioTemplate, error) {
+	response, err := getSteamfitterScenarioTemplateByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when reading scenario template", status)
+	}
+
+	template := &structs.SteamfitterScenarioTemplate{}
+	err = json.NewDecoder(response.Body).Decode(template)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read scenario template")
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// UpdateSteamfitterScenarioTemplate wraps the steamfitter API call to update a scenario template
+//
+// param template: A struct containing the ID of the scenario template and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateSteamfitterScenarioTemplate(template *structs.SteamfitterScenarioTemplate, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := steamfitterScenarioTemplatePayload(template)
+	payload["id"] = template.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarioTemplates/" + template.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Steamfitter API returned with status code %d when updating scenario template", status)
+	}
+	return nil
+}
+
+// DeleteSteamfitterScenarioTemplate wraps the steamfitter API call to delete a scenario template
+//
+// Param id: The id of the scenario template to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteSteamfitterScenarioTemplate(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarioTemplates/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Steamfitter API returned with status code %d when deleting scenario template", status)
+	}
+	return nil
+}
+
+// SteamfitterScenarioTemplateExists returns whether a scenario template exists along with an error value
+func SteamfitterScenarioTemplateExists(id string, m map[string]string) (bool, error) {
+	response, err := getSteamfitterScenarioTemplateByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a scenario template by its ID and returns the HTTP response
+func getSteamfitterScenarioTemplateByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "scenarioTemplates/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func steamfitterScenarioTemplatePayload(template *structs.SteamfitterScenarioTemplate) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          template.Name,
+		"description":   template.Description,
+		"durationHours": template.DurationHours,
+	}
+}
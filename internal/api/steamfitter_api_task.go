@@ -0,0 +1,237 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateSteamfitterTask wraps the create task POST call in steamfitter API
+//
+// param task: A struct containing the fields of the task
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created task and error on failure or nil on success
+func CreateSteamfitterTask(task *structs.SteamfitterTask, m map[string]string) (*structs.SteamfitterTask, error) {
+	log.Printf("! At top of API wrapper to create task")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := steamfitterTaskPayload(task)
+
+	log.Printf("! Creating task with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetSteamfitterApiUrl(m)+"tasks", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusCreated {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when creating task", status)
+	}
+
+	created := &structs.SteamfitterTask{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadSteamfitterTask wraps the steamfitter API call to read the fields of a task
+//
+// Param id: the id of the task to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the task on success
+func ReadSteamfitterTask(id string, m map[string]string) (*structs.SteamfitterTask, error) {
+	response, err := getSteamfitterTaskByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Steamfitter API returned with status code %d when reading task", status)
+	}
+
+	task := &structs.SteamfitterTask{}
+	err = json.NewDecoder(response.Body).Decode(task)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read task")
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// UpdateSteamfitterTask wraps the steamfitter API call to update a task
+//
+// param task: A struct containing the ID of the task and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateSteamfitterTask(task *structs.SteamfitterTask, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := steamfitterTaskPayload(task)
+	payload["id"] = task.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "tasks/" + task.Id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("Steamfitter API returned with status code %d when updating task", status)
+	}
+	return nil
+}
+
+// DeleteSteamfitterTask wraps the steamfitter API call to delete a task
+//
+// Param id: The id of the task to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteSteamfitterTask(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "tasks/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusNoContent {
+		return fmt.Errorf("Steamfitter API returned with status code %d when deleting task", status)
+	}
+	return nil
+}
+
+// SteamfitterTaskExists returns whether a task exists along with an error value
+func SteamfitterTaskExists(id string, m map[string]string) (bool, error) {
+	response, err := getSteamfitterTaskByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a task by its ID and returns the HTTP response
+func getSteamfitterTaskByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetSteamfitterApiUrl(m) + "tasks/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call. Steamfitter expects null rather than an empty string for IDs that
+// aren't set.
+func steamfitterTaskPayload(task *structs.SteamfitterTask) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":               task.Name,
+		"description":        task.Description,
+		"scenarioTemplateId": nil,
+		"scenarioId":         nil,
+		"action":             task.Action,
+		"vmMask":             task.VmMask,
+		"apiUrl":             task.ApiUrl,
+		"actionParameters":   task.ActionParameters,
+		"expectedOutput":     task.ExpectedOutput,
+		"expirationSeconds":  task.ExpirationSeconds,
+		"delaySeconds":       task.DelaySeconds,
+		"triggerTaskId":      nil,
+		"triggerCondition":   task.TriggerCondition,
+		"userExecutable":     task.UserExecutable,
+	}
+
+	if task.ScenarioTemplateId != "" {
+		payload["scenarioTemplateId"] = task.ScenarioTemplateId
+	}
+	if task.ScenarioId != "" {
+		payload["scenarioId"] = task.ScenarioId
+	}
+	if task.TriggerTaskId != "" {
+		payload["triggerTaskId"] = task.TriggerTaskId
+	}
+	return payload
+}
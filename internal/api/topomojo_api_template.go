@@ -0,0 +1,272 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateTopomojoTemplate wraps the create template POST call in TopoMojo API
+//
+// param template: A struct containing the fields of the template
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created template and error on failure or nil on success
+func CreateTopomojoTemplate(template *structs.TopomojoTemplate, m map[string]string) (*structs.TopomojoTemplate, error) {
+	log.Printf("! At top of API wrapper to create template")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := topomojoTemplatePayload(template)
+
+	log.Printf("! Creating template with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetTopomojoApiUrl(m)+"template", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("TopoMojo API returned with status code %d when creating template", status)
+	}
+
+	created := &structs.TopomojoTemplate{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadTopomojoTemplate wraps the TopoMojo API call to read the fields of a template
+//
+// Param id: the id of the template to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the template on success
+func ReadTopomojoTemplate(id string, m map[string]string) (*structs.TopomojoTemplate, error) {
+	response, err := getTopomojoTemplateByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("TopoMojo API returned with status code %d when reading template", status)
+	}
+
+	template := &structs.TopomojoTemplate{}
+	err = json.NewDecoder(response.Body).Decode(template)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read template")
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// UpdateTopomojoTemplate wraps the TopoMojo API call to update a template
+//
+// param template: A struct containing the ID of the template and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateTopomojoTemplate(template *structs.TopomojoTemplate, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := topomojoTemplatePayload(template)
+	payload["id"] = template.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "template"
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("TopoMojo API returned with status code %d when updating template", status)
+	}
+	return nil
+}
+
+// DeleteTopomojoTemplate wraps the TopoMojo API call to delete a template
+//
+// Param id: The id of the template to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteTopomojoTemplate(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "template/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("TopoMojo API returned with status code %d when deleting template", status)
+	}
+	return nil
+}
+
+// LinkTopomojoTemplate wraps the TopoMojo API call to link a published template into a workspace. The linked template
+// shares the disk of its parent instead of getting a copy.
+//
+// param parentID: The id of the published template to link
+//
+// param workspaceID: The id of the workspace to link it into
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the linked template and error on failure or nil on success
+func LinkTopomojoTemplate(parentID, workspaceID string, m map[string]string) (*structs.TopomojoTemplate, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"templateId":  parentID,
+		"workspaceId": workspaceID,
+	}
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetTopomojoApiUrl(m)+"template/link", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("TopoMojo API returned with status code %d when linking template %s", status, parentID)
+	}
+
+	linked := &structs.TopomojoTemplate{}
+	err = json.NewDecoder(response.Body).Decode(linked)
+	if err != nil {
+		return nil, err
+	}
+
+	return linked, nil
+}
+
+// TopomojoTemplateExists returns whether a template exists along with an error value
+func TopomojoTemplateExists(id string, m map[string]string) (bool, error) {
+	response, err := getTopomojoTemplateByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a template by its ID and returns the HTTP response
+func getTopomojoTemplateByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "template/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func topomojoTemplatePayload(template *structs.TopomojoTemplate) map[string]interface{} {
+	return map[string]interface{}{
+		"workspaceId": template.WorkspaceId,
+		"name":        template.Name,
+		"description": template.Description,
+		"networks":    template.Networks,
+		"guestinfo":   template.Guestinfo,
+		"isHidden":    template.IsHidden,
+		"isPublished": template.IsPublished,
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// -------------------- API Wrappers --------------------
+
+// CreateTopomojoWorkspace wraps the create workspace POST call in TopoMojo API
+//
+// param workspace: A struct containing the fields of the workspace
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the created workspace and error on failure or nil on success
+func CreateTopomojoWorkspace(workspace *structs.TopomojoWorkspace, m map[string]string) (*structs.TopomojoWorkspace, error) {
+	log.Printf("! At top of API wrapper to create workspace")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := topomojoWorkspacePayload(workspace)
+
+	log.Printf("! Creating workspace with payload %s", util.Redact(payload))
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", util.GetTopomojoApiUrl(m)+"workspace", bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("TopoMojo API returned with status code %d when creating workspace", status)
+	}
+
+	created := &structs.TopomojoWorkspace{}
+	err = json.NewDecoder(response.Body).Decode(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReadTopomojoWorkspace wraps the TopoMojo API call to read the fields of a workspace
+//
+// Param id: the id of the workspace to read
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or the workspace on success
+func ReadTopomojoWorkspace(id string, m map[string]string) (*structs.TopomojoWorkspace, error) {
+	response, err := getTopomojoWorkspaceByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("TopoMojo API returned with status code %d when reading workspace", status)
+	}
+
+	workspace := &structs.TopomojoWorkspace{}
+	err = json.NewDecoder(response.Body).Decode(workspace)
+	if err != nil {
+		log.Printf("! Error unmarshaling in read workspace")
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+// UpdateTopomojoWorkspace wraps the TopoMojo API call to update a workspace
+//
+// param workspace: A struct containing the ID of the workspace and its new fields
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UpdateTopomojoWorkspace(workspace *structs.TopomojoWorkspace, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	payload := topomojoWorkspacePayload(workspace)
+	payload["id"] = workspace.Id
+
+	asJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "workspace"
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("TopoMojo API returned with status code %d when updating workspace", status)
+	}
+	return nil
+}
+
+// DeleteTopomojoWorkspace wraps the TopoMojo API call to delete a workspace
+//
+// Param id: The id of the workspace to delete
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func DeleteTopomojoWorkspace(id string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "workspace/" + id
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("TopoMojo API returned with status code %d when deleting workspace", status)
+	}
+	return nil
+}
+
+// UploadTopomojoDocument wraps the TopoMojo API call to replace the markdown document of a workspace
+//
+// param id: The id of the workspace
+//
+// param document: The markdown text of the document
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns error on failure or nil on success
+func UploadTopomojoDocument(id, document string, m map[string]string) error {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	asJSON, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "document/" + id
+	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	request.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return fmt.Errorf("TopoMojo API returned with status code %d when uploading document", status)
+	}
+	return nil
+}
+
+// ReadTopomojoDocument wraps the TopoMojo API call to read the markdown document of a workspace
+//
+// param id: The id of the workspace
+//
+// param m: A map containing configuration info for the provider
+//
+// Returns the document and error on failure or nil on success
+func ReadTopomojoDocument(id string, m map[string]string) (string, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return "", err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "document/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	status := response.StatusCode
+	if status != http.StatusOK {
+		return "", fmt.Errorf("TopoMojo API returned with status code %d when reading document", status)
+	}
+
+	var document string
+	err = json.NewDecoder(response.Body).Decode(&document)
+	if err != nil {
+		return "", err
+	}
+
+	return document, nil
+}
+
+// TopomojoWorkspaceExists returns whether a workspace exists along with an error value
+func TopomojoWorkspaceExists(id string, m map[string]string) (bool, error) {
+	response, err := getTopomojoWorkspaceByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode != http.StatusNotFound, nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Gets a workspace by its ID and returns the HTTP response
+func getTopomojoWorkspaceByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetTopomojoApiUrl(m) + "workspace/" + id
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", "Bearer "+auth)
+	client := &http.Client{}
+
+	return client.Do(request)
+}
+
+// Builds the body of a create or update call
+func topomojoWorkspacePayload(workspace *structs.TopomojoWorkspace) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        workspace.Name,
+		"description": workspace.Description,
+		"author":      workspace.Author,
+		"audience":    workspace.Audience,
+	}
+}
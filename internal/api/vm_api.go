@@ -45,7 +45,7 @@ func CreateVM(requestBody *structs.VMInfo, m map[string]string) error {
 	req.Header.Set("Content-Type", "application/json")
 	client := &http.Client{}
 
-	log.Printf("! JSON being sent to API:\n %v", string(asJSON))
+	log.Printf("! JSON being sent to API:\n %v", util.Redact(asJSON))
 	// Make the request
 	resp, err := client.Do(req)
 	if err != nil {
@@ -231,14 +231,14 @@ func RemoveVMFromTeams(teams *[]string, vm string, m map[string]string) error {
 		client := &http.Client{}
 
 		log.Printf("! url = %v", url)
-		log.Printf("! request = %+v", req)
+		log.Printf("! request = %s", util.Redact(req))
 
 		resp, err := client.Do(req)
 		if err != nil {
 			return err
 		}
 
-		log.Printf("! response: %+v", resp)
+		log.Printf("! response: %s", util.Redact(resp))
 
 		status := resp.StatusCode
 		if status != http.StatusNoContent {
@@ -276,14 +276,14 @@ func AddVMToTeams(teams *[]string, vm string, m map[string]string) error {
 		client := &http.Client{}
 
 		log.Printf("! url = %v", url)
-		log.Printf("! request = %+v", req)
+		log.Printf("! request = %s", util.Redact(req))
 
 		resp, err := client.Do(req)
 		if err != nil {
 			return err
 		}
 
-		log.Printf("! response: %+v", resp)
+		log.Printf("! response: %s", util.Redact(resp))
 
 		status := resp.StatusCode
 		if status != http.StatusOK {
@@ -294,6 +294,130 @@ func AddVMToTeams(teams *[]string, vm string, m map[string]string) error {
 	return nil
 }
 
+// VMConsoleAvailable returns true if the VM API is able to open a console to the given vSphere VM. The VM API
+// validates its connection to the hypervisor when answering this call, so a successful response means the
+// console can be reached by players.
+//
+// param id: The ID of the VM
+//
+// param m: A map containing config info for the provider
+func VMConsoleAvailable(id string, m map[string]string) (bool, error) {
+	log.Printf("! In VMConsoleAvailable")
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return false, err
+	}
+
+	url := util.GetVmApiUrl(m) + "vms/vsphere/" + id
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	log.Printf("! In VMConsoleAvailable, request returned with status code %d", resp.StatusCode)
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+// ProxmoxVMExists returns true if the VM API can find the Proxmox VM that the given VM points to. The VM API looks
+// the VM up on the node given in its proxmox info, so this fails for a node or VM ID that doesn't exist.
+//
+// param id: The ID of the VM
+//
+// param m: A map containing config info for the provider
+func ProxmoxVMExists(id string, m map[string]string) (bool, error) {
+	log.Printf("! In ProxmoxVMExists")
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return false, err
+	}
+
+	url := util.GetVmApiUrl(m) + "vms/proxmox/" + id
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	log.Printf("! In ProxmoxVMExists, request returned with status code %d", resp.StatusCode)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("VM API returned with status code %d when looking up Proxmox VM for %s", resp.StatusCode, id)
+	}
+}
+
+// ChangeVMPowerState wraps the VM API power actions
+//
+// param id: The ID of the VM
+//
+// param action: The power action to perform, either "power-on" or "power-off"
+//
+// param m: A map containing config info for the provider
+//
+// Returns nil on success or some error on failure
+func ChangeVMPowerState(id, action string, m map[string]string) error {
+	log.Printf("! In ChangeVMPowerState, performing %s on VM %s", action, id)
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	asJSON, err := json.Marshal(map[string]interface{}{
+		"ids": []string{id},
+	})
+	if err != nil {
+		return err
+	}
+
+	url := util.GetVmApiUrl(m) + "vms/actions/" + action
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("VM API returned status %d when performing %s on VM %s", resp.StatusCode, action, id)
+	}
+
+	// The bulk power endpoints report per-VM failures in the body rather than through the status code
+	body := make(map[string]interface{})
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	if err == nil && body["errors"] != nil {
+		if errs, ok := body["errors"].(map[string]interface{}); ok && errs[id] != nil {
+			return fmt.Errorf("VM API could not perform %s on VM %s: %v", action, id, errs[id])
+		}
+	}
+
+	return nil
+}
+
 // -------------------- Helper functions --------------------
 
 // Returns the HTTP response from a GET call to get a VM's info
@@ -319,7 +443,7 @@ func getVMByID(id string, m map[string]string) (*http.Response, error) {
 	client := &http.Client{}
 	resp, err := client.Do(req)
 
-	log.Printf("! response: %+v", resp)
+	log.Printf("! response: %s", util.Redact(resp))
 
 	if err != nil {
 		log.Printf("! In getVMByID, error making request")
@@ -341,7 +465,7 @@ func unpackResponse(resp *http.Response) *structs.VMInfo {
 	asMap := make(map[string]interface{})
 	json.Unmarshal([]byte(asStr), &asMap)
 
-	log.Printf("! Data returned by GET call:\n%v", asMap)
+	log.Printf("! Data returned by GET call:\n%v", util.Redact(asMap))
 
 	teams := asMap["teamIds"].([]interface{})
 	teamsConverted := util.ToStringSlice(&teams)
@@ -355,7 +479,11 @@ func unpackResponse(resp *http.Response) *structs.VMInfo {
 	var proxmoxPtr *structs.ProxmoxInfo
 	proxmox := asMap["proxmoxVmInfo"]
 	if proxmox != nil {
-		proxmoxPtr = structs.ProxmoxInfoFromMap(proxmox.(map[string]interface{}))
+		var err error
+		proxmoxPtr, err = structs.ProxmoxInfoFromMap(proxmox.(map[string]interface{}))
+		if err != nil {
+			log.Printf("! Unable to parse proxmox vm info returned by API: %v", err)
+		}
 	}
 
 	// set defaults if defaultUrl and embeddable don't exist (older api versions)
@@ -373,6 +501,12 @@ func unpackResponse(resp *http.Response) *structs.VMInfo {
 		embeddable = embeddableObj.(bool)
 	}
 
+	// Older api versions don't report a power state
+	powerState := "Unknown"
+	if asMap["powerState"] != nil {
+		powerState = fmt.Sprintf("%v", asMap["powerState"])
+	}
+
 	// Unpack the map into a struct. We *should* be able to unmarshal right into the struct, but it's refusing
 	// to parse the userId field for some reason. This is logically the same, just rather inelegant
 	ret := &structs.VMInfo{
@@ -385,6 +519,7 @@ func unpackResponse(resp *http.Response) *structs.VMInfo {
 		Embeddable: embeddable,
 		Connection: connectionPtr,
 		Proxmox:    proxmoxPtr,
+		PowerState: powerState,
 	}
 	return ret
 }
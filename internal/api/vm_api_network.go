@@ -198,7 +198,7 @@ func unpackViewNetworkResponse(resp *http.Response) (*structs.ViewNetworkInfo, e
 		return nil, err
 	}
 
-	log.Printf("! ViewNetwork response data: %v", asMap)
+	log.Printf("! ViewNetwork response data: %v", util.Redact(asMap))
 
 	var teamIds []string
 	if asMap["teamIds"] != nil {
@@ -0,0 +1,209 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"net/http"
+)
+
+// CreateUsageLoggingSession wraps the POST call to create a VM usage logging session in the VM API.
+func CreateUsageLoggingSession(session *structs.UsageLoggingSession, m map[string]string) (*structs.UsageLoggingSession, error) {
+	log.Printf("! In CreateUsageLoggingSession API wrapper")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	asJSON, err := json.Marshal(usageLoggingSessionPayload(session))
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetVmApiUrl(m) + "vmusageloggingsessions"
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("VM API returned status %d when creating usage logging session for view %s", resp.StatusCode, session.ViewID)
+	}
+
+	result, err := unpackUsageLoggingSessionResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("! Usage logging session created with ID %s", result.ID)
+	return result, nil
+}
+
+// GetUsageLoggingSession wraps the GET call to read a single VM usage logging session.
+func GetUsageLoggingSession(id string, m map[string]string) (*structs.UsageLoggingSession, error) {
+	log.Printf("! In GetUsageLoggingSession API wrapper")
+
+	resp, err := getUsageLoggingSessionByID(id, m)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("VM API returned status %d when reading usage logging session %s", resp.StatusCode, id)
+	}
+
+	return unpackUsageLoggingSessionResponse(resp)
+}
+
+// UpdateUsageLoggingSession wraps the PUT call to update a VM usage logging session.
+func UpdateUsageLoggingSession(session *structs.UsageLoggingSession, m map[string]string) error {
+	log.Printf("! In UpdateUsageLoggingSession API wrapper")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	asJSON, err := json.Marshal(usageLoggingSessionPayload(session))
+	if err != nil {
+		return err
+	}
+
+	url := util.GetVmApiUrl(m) + "vmusageloggingsessions/" + session.ID
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(asJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("VM API returned status %d when updating usage logging session %s", resp.StatusCode, session.ID)
+	}
+
+	log.Printf("! Usage logging session %s updated", session.ID)
+	return nil
+}
+
+// DeleteUsageLoggingSession wraps the DELETE call to remove a VM usage logging session.
+func DeleteUsageLoggingSession(id string, m map[string]string) error {
+	log.Printf("! In DeleteUsageLoggingSession API wrapper")
+
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return err
+	}
+
+	url := util.GetVmApiUrl(m) + "vmusageloggingsessions/" + id
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("VM API returned status %d when deleting usage logging session %s", resp.StatusCode, id)
+	}
+
+	log.Printf("! Usage logging session %s deleted", id)
+	return nil
+}
+
+// UsageLoggingSessionExists returns true if a VM usage logging session with the given ID exists.
+func UsageLoggingSessionExists(id string, m map[string]string) (bool, error) {
+	log.Printf("! In UsageLoggingSessionExists")
+
+	resp, err := getUsageLoggingSessionByID(id, m)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode != http.StatusNotFound, nil
+}
+
+// GetUsageLogDownloadURL returns the URL that a session's usage log can be downloaded from as a CSV file.
+// Downloading it requires the same bearer token as any other VM API call.
+func GetUsageLogDownloadURL(id string, m map[string]string) string {
+	return util.GetVmApiUrl(m) + "vmusageloggingsessions/" + id + "/log-entries/download"
+}
+
+// -------------------- Helper functions --------------------
+
+func getUsageLoggingSessionByID(id string, m map[string]string) (*http.Response, error) {
+	auth, err := util.GetAuth(m)
+	if err != nil {
+		return nil, err
+	}
+
+	url := util.GetVmApiUrl(m) + "vmusageloggingsessions/" + id
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+auth)
+
+	client := &http.Client{}
+	return client.Do(req)
+}
+
+// Unset optional fields are sent as null so the API applies its defaults
+func usageLoggingSessionPayload(session *structs.UsageLoggingSession) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           util.Ternary(session.ID == "", nil, session.ID),
+		"viewId":       session.ViewID,
+		"teamIds":      session.TeamIDs,
+		"sessionName":  session.SessionName,
+		"sessionStart": util.Ternary(session.SessionStart == "", nil, session.SessionStart),
+		"sessionEnd":   util.Ternary(session.SessionEnd == "", nil, session.SessionEnd),
+	}
+}
+
+func unpackUsageLoggingSessionResponse(resp *http.Response) (*structs.UsageLoggingSession, error) {
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(resp.Body)
+
+	log.Printf("! Usage logging session response data: %s", util.Redact(buf.Bytes()))
+
+	session := &structs.UsageLoggingSession{}
+	err := json.Unmarshal(buf.Bytes(), session)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.TeamIDs == nil {
+		session.TeamIDs = []string{}
+	}
+	return session, nil
+}
@@ -0,0 +1,303 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Statuses of an event that is over. An event in one of these can't be used again.
+var alloyEventOverStatuses = []string{"Ended", "Expired"}
+
+// An event launched from an Alloy event template. Creating this resource launches the event and waits for it to
+// become active. Destroying it ends the event and waits for Alloy to tear it down.
+func alloyEvent() *schema.Resource {
+	return &schema.Resource{
+		Create: alloyEventCreate,
+		Read:   alloyEventRead,
+		Update: alloyEventUpdate,
+		Delete: alloyEventDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"poll_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "10s",
+				ValidateFunc: util.ValidateDuration,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internal_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scenario_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Call API to launch an event from the template, then wait for it to become active
+// If the launch fails, return an error with Alloy's status. The event stays in state so it is tainted and ended on
+// the next apply
+func alloyEventCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	event, err := api.LaunchAlloyEvent(d.Get("event_template_id").(string), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(event.Id)
+	log.Printf("! Alloy event launched with ID %s", d.Id())
+
+	event, err = waitForAlloyEvent(d, []string{"Creating"}, d.Timeout(schema.TimeoutCreate), casted)
+	if err != nil {
+		return err
+	}
+
+	err = setAlloyEventState(d, event)
+	if err != nil {
+		return err
+	}
+
+	if event.Status != "Active" {
+		return alloyEventFailure(event, "launched")
+	}
+
+	return alloyEventRead(d, m)
+}
+
+// Check if event exists and is not over. If not, set id to "" and return nil
+// Read event info from API
+// Use it to update local state
+func alloyEventRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.AlloyEventExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	event, err := api.ReadAlloyEvent(id, casted)
+	if err != nil {
+		return err
+	}
+
+	// An event that was ended or expired outside of Terraform has to be launched again
+	if util.StrSliceContains(&alloyEventOverStatuses, event.Status) {
+		log.Printf("! Alloy event %s is %s, removing it from state", id, event.Status)
+		d.SetId("")
+		return nil
+	}
+
+	return setAlloyEventState(d, event)
+}
+
+// Only poll_interval can change without launching a new event, and it is only used while waiting on the event
+func alloyEventUpdate(d *schema.ResourceData, m interface{}) error {
+	return alloyEventRead(d, m)
+}
+
+// Check if event exists and is not already over
+// Call API to end it, then wait for Alloy to finish ending it
+func alloyEventDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.AlloyEventExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	event, err := api.ReadAlloyEvent(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if util.StrSliceContains(&alloyEventOverStatuses, event.Status) {
+		return nil
+	}
+
+	err = api.EndAlloyEvent(id, casted)
+	if err != nil {
+		return err
+	}
+
+	event, err = waitForAlloyEvent(d, []string{"Creating", "Active", "Paused", "Ending"}, d.Timeout(schema.TimeoutDelete), casted)
+	if err != nil {
+		return err
+	}
+
+	if !util.StrSliceContains(&alloyEventOverStatuses, event.Status) {
+		return alloyEventFailure(event, "ended")
+	}
+
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Waits until the event is no longer in one of the pending statuses, polling every poll_interval until timeout
+func waitForAlloyEvent(d *schema.ResourceData, pending []string, timeout time.Duration, m map[string]string) (*structs.AlloyEvent, error) {
+	// This has already been validated by the schema
+	interval, _ := time.ParseDuration(d.Get("poll_interval").(string))
+	id := d.Id()
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"done"},
+		Timeout:      timeout,
+		PollInterval: interval,
+		Refresh: func() (interface{}, string, error) {
+			event, err := api.ReadAlloyEvent(id, m)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("! Waiting on Alloy event %s, status is %s (%s)", id, event.Status, event.InternalStatus)
+			if util.StrSliceContains(&pending, event.Status) {
+				return event, "pending", nil
+			}
+			return event, "done", nil
+		},
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for Alloy event %s: %v", id, err)
+	}
+	return result.(*structs.AlloyEvent), nil
+}
+
+// Returns an error describing an event that could not be launched or ended, with the step Alloy stopped at
+func alloyEventFailure(event *structs.AlloyEvent, action string) error {
+	message := fmt.Sprintf("Alloy event %s could not be %s. Its status is %s", event.Id, action, event.Status)
+
+	step := event.InternalStatus
+	if action == "launched" && event.LastLaunchInternalStatus != "" {
+		step = event.LastLaunchInternalStatus
+	} else if action == "ended" && event.LastEndInternalStatus != "" {
+		step = event.LastEndInternalStatus
+	}
+
+	if step != "" {
+		message += fmt.Sprintf(", stopped at %s", step)
+	}
+	if event.FailureCount > 0 {
+		message += fmt.Sprintf(" after %d failed attempts", event.FailureCount)
+	}
+	return fmt.Errorf("%s", message)
+}
+
+// Sets the computed attributes of an event in state
+func setAlloyEventState(d *schema.ResourceData, event *structs.AlloyEvent) error {
+	err := d.Set("event_template_id", event.EventTemplateId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", event.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", event.Status)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("internal_status", event.InternalStatus)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("view_id", event.ViewId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("workspace_id", event.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scenario_id", event.ScenarioId)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("expiration_date", event.ExpirationDate)
+}
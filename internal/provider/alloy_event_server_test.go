@@ -0,0 +1,102 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for launching and ending an event against a stub of the Alloy API
+//
+// Execution steps
+// 1. Terraform launches an event from a template
+// 2. Verify the event is active and its view is in local state
+// 3. Terraform changes the poll interval
+// 4. Verify the same event is kept
+// 5. Terraform destroys the event
+// 6. Verify the event was ended rather than deleted
+//
+// Expected behavior:
+// Launching waits for the event to become active and destroying ends it
+func TestAlloyEvent(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	stub.handle("POST", "eventTemplates/{id}/events", func(id string, body []byte) (int, interface{}) {
+		return http.StatusCreated, stub.insert("events", map[string]interface{}{
+			"eventTemplateId": id,
+			"name":            "Exercise",
+			"status":          "Active",
+			"internalStatus":  "Launched",
+			"viewId":          "2d0a2b6e-7c4b-4f3e-9b1a-6c5d4e3f2a10",
+		})
+	})
+	stub.handle("DELETE", "events/{id}/end", func(id string, body []byte) (int, interface{}) {
+		event := stub.get("events", id)
+		if event == nil {
+			return http.StatusNotFound, nil
+		}
+		event["status"] = "Ended"
+		event["internalStatus"] = "Ended"
+		return http.StatusNoContent, nil
+	})
+
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if len(stub.find("events", "status", "Ended")) != 1 {
+				return fmt.Errorf("expected the event to be ended after destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configAlloyEvent("1s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_alloy_event.test", "status", "Active"),
+					resource.TestCheckResourceAttr("crucible_alloy_event.test", "name", "Exercise"),
+					resource.TestCheckResourceAttr("crucible_alloy_event.test", "view_id", "2d0a2b6e-7c4b-4f3e-9b1a-6c5d4e3f2a10"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["crucible_alloy_event.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configAlloyEvent("2s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_alloy_event.test", "poll_interval", "2s"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_alloy_event.test"].Primary.ID != id {
+							return fmt.Errorf("expected the event to be kept when the poll interval changes")
+						}
+						if stub.count("events") != 1 {
+							return fmt.Errorf("expected 1 event in the stub, found %d", stub.count("events"))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configAlloyEvent(pollInterval string) string {
+	return fmt.Sprintf(`
+	resource "crucible_alloy_event" "test" {
+		event_template_id = "9c8b7a69-5847-4362-a514-03f2e1d0c9b8"
+		poll_interval     = "%s"
+	}
+	`, pollInterval)
+}
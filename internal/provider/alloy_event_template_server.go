@@ -0,0 +1,224 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// An event template ties a Player view, a Caster directory and a Steamfitter scenario template together. Alloy
+// launches events from it.
+func alloyEventTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: alloyEventTemplateCreate,
+		Read:   alloyEventTemplateRead,
+		Update: alloyEventTemplateUpdate,
+		Delete: alloyEventTemplateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scenario_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"duration_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"use_dynamic_host": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_published": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Get event template properties from d
+// Call API to create event template
+// Call read to make sure everything worked
+func alloyEventTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateAlloyEventTemplate(alloyEventTemplateFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Alloy event template created with ID %s", d.Id())
+	return alloyEventTemplateRead(d, m)
+}
+
+// Check if event template exists. If not, set id to "" and return nil
+// Read event template info from API
+// Use it to update local state
+func alloyEventTemplateRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.AlloyEventTemplateExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	template, err := api.ReadAlloyEventTemplate(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", template.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", template.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("view_id", template.ViewId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("directory_id", template.DirectoryId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scenario_template_id", template.ScenarioTemplateId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("duration_hours", template.DurationHours)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("use_dynamic_host", template.UseDynamicHost)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("is_published", template.IsPublished)
+}
+
+// Get event template properties from d
+// Call API to update event template
+// Call read to make sure everything worked
+func alloyEventTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	template := alloyEventTemplateFromConfig(d)
+	template.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateAlloyEventTemplate(template, casted)
+	if err != nil {
+		return err
+	}
+
+	return alloyEventTemplateRead(d, m)
+}
+
+// Check if event template exists
+// Call API to delete it
+func alloyEventTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "alloy_api_url", "Alloy")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.AlloyEventTemplateExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteAlloyEventTemplate(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds an event template struct from the resource's config
+func alloyEventTemplateFromConfig(d *schema.ResourceData) *structs.AlloyEventTemplate {
+	return &structs.AlloyEventTemplate{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		ViewId:             d.Get("view_id").(string),
+		DirectoryId:        d.Get("directory_id").(string),
+		ScenarioTemplateId: d.Get("scenario_template_id").(string),
+		DurationHours:      d.Get("duration_hours").(int),
+		UseDynamicHost:     d.Get("use_dynamic_host").(bool),
+		IsPublished:        d.Get("is_published").(bool),
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of an event template against a stub of the Alloy API
+//
+// Execution steps
+// 1. Terraform creates an event template without a scenario template
+// 2. Verify local state and the event template in the stub
+// 3. Terraform renames the event template, adds a scenario template and publishes it
+// 4. Verify the event template was updated in place
+// 5. Terraform destroys the event template
+//
+// Expected behavior:
+// The event template is created, updated, and destroyed without error
+func TestAlloyEventTemplate(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("eventTemplates") != 0 {
+				return fmt.Errorf("expected no event templates after destroy, found %d", stub.count("eventTemplates"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configAlloyEventTemplate("Exercise", "", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_alloy_event_template.test", "name", "Exercise"),
+					resource.TestCheckResourceAttr("crucible_alloy_event_template.test", "duration_hours", "4"),
+					resource.TestCheckResourceAttr("crucible_alloy_event_template.test", "scenario_template_id", ""),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["crucible_alloy_event_template.test"].Primary.ID
+						if len(stub.find("eventTemplates", "viewId", "2d0a2b6e-7c4b-4f3e-9b1a-6c5d4e3f2a10")) != 1 {
+							return fmt.Errorf("expected an event template for the view in the stub")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configAlloyEventTemplate("Exercise 2", "7e6d5c4b-3a29-4817-a6f5-e4d3c2b1a098", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_alloy_event_template.test", "name", "Exercise 2"),
+					resource.TestCheckResourceAttr("crucible_alloy_event_template.test", "is_published", "true"),
+					resource.TestCheckResourceAttr("crucible_alloy_event_template.test", "scenario_template_id",
+						"7e6d5c4b-3a29-4817-a6f5-e4d3c2b1a098"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_alloy_event_template.test"].Primary.ID != id {
+							return fmt.Errorf("expected the event template to be updated in place")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configAlloyEventTemplate(name, scenarioTemplateID string, published bool) string {
+	return fmt.Sprintf(`
+	resource "crucible_alloy_event_template" "test" {
+		name                 = "%s"
+		view_id              = "2d0a2b6e-7c4b-4f3e-9b1a-6c5d4e3f2a10"
+		scenario_template_id = "%s"
+		is_published         = %t
+	}
+	`, name, scenarioTemplateID, published)
+}
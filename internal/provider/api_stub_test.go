@@ -0,0 +1,322 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// apiStub is a local stand-in for the Crucible APIs and the identity server, so resources can be tested without a
+// Crucible deployment. Every API URL in the provider points at the same stub. Objects are kept in memory as JSON maps,
+// grouped by the collection in their URL.
+//
+// Objects can be locked to simulate a Caster run in progress. Changes to a locked object, or to a file in a locked
+// workspace, are refused with 409 Conflict until it is unlocked.
+//
+// Calls that aren't plain CRUD, such as launching an Alloy event, are answered by handlers that tests add with handle.
+type apiStub struct {
+	server   *httptest.Server
+	lock     sync.Mutex
+	objects  map[string]map[string]map[string]interface{}
+	locked   map[string]bool
+	unlocks  int
+	nextID   int
+	handlers map[string]stubHandler
+}
+
+// Answers a call that isn't plain CRUD. The ID is the one in the path of the call, if there is one. Handlers are
+// called with the stub locked, so they can use insert and get but not the other methods of the stub.
+type stubHandler func(id string, body []byte) (int, interface{})
+
+// Collections of the APIs that follow TopoMojo's conventions rather than Caster's. Objects are created with 200 OK
+// instead of 201 Created, updated with a PUT to the collection that has the ID in the body, and deleted with 200 OK
+// instead of 204 No Content.
+var stubTopomojoStyleCollections = map[string]bool{
+	"workspace":     true,
+	"template":      true,
+	"game":          true,
+	"challengespec": true,
+	"account":       true,
+	"client":        true,
+}
+
+// Collections of the identity server, which uses integer IDs
+var stubIntegerIDCollections = map[string]bool{
+	"account": true,
+	"client":  true,
+}
+
+// Starts a stub server. Callers must close it with stub.server.Close()
+func newAPIStub() *apiStub {
+	stub := &apiStub{
+		objects:  make(map[string]map[string]map[string]interface{}),
+		locked:   make(map[string]bool),
+		handlers: make(map[string]stubHandler),
+	}
+	stub.server = httptest.NewServer(stub)
+	return stub
+}
+
+// Returns a provider block that points every URL at the stub
+func (stub *apiStub) providerConfig() string {
+	return fmt.Sprintf(`provider "crucible" {
+		username            = "stub"
+		password            = "stub"
+		auth_url            = "%[1]s/auth"
+		token_url           = "%[1]s/token"
+		client_id           = "stub"
+		client_secret       = "stub"
+		vm_api_url          = "%[1]s"
+		player_api_url      = "%[1]s"
+		caster_api_url      = "%[1]s"
+		alloy_api_url       = "%[1]s"
+		steamfitter_api_url = "%[1]s"
+		blueprint_api_url   = "%[1]s"
+		cite_api_url        = "%[1]s"
+		gallery_api_url     = "%[1]s"
+		topomojo_api_url    = "%[1]s"
+		gameboard_api_url   = "%[1]s"
+		identity_api_url    = "%[1]s"
+	}
+
+	`, stub.server.URL)
+}
+
+// Answers calls with the given method and path with fn. The path is relative to the API root, with {id} standing in
+// for an ID, e.g. "eventTemplates/{id}/events".
+func (stub *apiStub) handle(method, path string, fn stubHandler) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.handlers[method+" "+path] = fn
+}
+
+// Adds an object to a collection, giving it a new ID. The stub must be locked.
+func (stub *apiStub) insert(collection string, object map[string]interface{}) map[string]interface{} {
+	if stub.objects[collection] == nil {
+		stub.objects[collection] = make(map[string]map[string]interface{})
+	}
+
+	if stubIntegerIDCollections[collection] {
+		stub.nextID++
+		object["id"] = stub.nextID
+	} else {
+		object["id"] = uuid.NewString()
+	}
+	stub.objects[collection][fmt.Sprintf("%v", object["id"])] = object
+	return object
+}
+
+// Returns the object with the given ID in a collection, or nil if there isn't one. The stub must be locked.
+func (stub *apiStub) get(collection, id string) map[string]interface{} {
+	return stub.objects[collection][id]
+}
+
+// Returns the objects in a collection that have the given field set to value
+func (stub *apiStub) find(collection, field, value string) []map[string]interface{} {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	found := []map[string]interface{}{}
+	for _, object := range stub.objects[collection] {
+		if fmt.Sprintf("%v", object[field]) == value {
+			found = append(found, object)
+		}
+	}
+	return found
+}
+
+// Returns the number of objects in a collection
+func (stub *apiStub) count(collection string) int {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	return len(stub.objects[collection])
+}
+
+// Locks or unlocks the object with the given ID
+func (stub *apiStub) setLocked(id string, locked bool) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.locked[id] = locked
+}
+
+// Returns the number of times a workspace was force unlocked through the API
+func (stub *apiStub) unlockCount() int {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	return stub.unlocks
+}
+
+func (stub *apiStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if r.URL.Path == "/token" {
+		writeStubJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": "stub",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"), "/"), "/")
+	collection := parts[0]
+	if stub.objects[collection] == nil {
+		stub.objects[collection] = make(map[string]map[string]interface{})
+	}
+
+	raw, _ := ioutil.ReadAll(r.Body)
+	if fn, id, found := stub.findHandler(r.Method, parts); found {
+		status, response := fn(id, raw)
+		if response == nil {
+			w.WriteHeader(status)
+			return
+		}
+		writeStubJSON(w, status, response)
+		return
+	}
+
+	body := make(map[string]interface{})
+	json.Unmarshal(raw, &body)
+
+	topomojoStyle := stubTopomojoStyleCollections[collection]
+	if len(parts) == 1 && r.Method == "POST" {
+		stub.insert(collection, body)
+		writeStubJSON(w, stubStatus(topomojoStyle, http.StatusOK, http.StatusCreated), body)
+		return
+	}
+
+	// TopoMojo style updates have the ID of the object in the body
+	if len(parts) == 1 && r.Method == "PUT" && topomojoStyle {
+		parts = append(parts, fmt.Sprintf("%v", body["id"]))
+	}
+
+	if len(parts) == 1 {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	object, exists := stub.objects[collection][parts[1]]
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if collection == "workspaces" {
+		object["isLocked"] = stub.locked[parts[1]]
+	}
+
+	workspaceID, _ := object["workspaceId"].(string)
+	if r.Method != "GET" && (stub.locked[parts[1]] || stub.locked[workspaceID]) {
+		if len(parts) == 4 && parts[3] == "unlock" && collection == "workspaces" {
+			stub.locked[parts[1]] = false
+			stub.unlocks++
+			writeStubJSON(w, http.StatusOK, object)
+			return
+		}
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	switch {
+	case len(parts) == 4 && parts[2] == "actions":
+		writeStubJSON(w, http.StatusOK, object)
+	case len(parts) == 3 && r.Method == "GET":
+		// Lists the children of the object, such as the categories of a scoring model at
+		// scoringModels/{id}/scoringCategories
+		children := []map[string]interface{}{}
+		for _, child := range stub.objects[parts[2]] {
+			if child[stubParentField(collection)] == parts[1] {
+				children = append(children, child)
+			}
+		}
+		writeStubJSON(w, http.StatusOK, children)
+	case r.Method == "GET":
+		writeStubJSON(w, http.StatusOK, object)
+	case r.Method == "PUT":
+		for key, value := range body {
+			// Keep integer IDs as they are rather than as the float64 they were decoded to
+			if key != "id" {
+				object[key] = value
+			}
+		}
+		writeStubJSON(w, http.StatusOK, object)
+	case r.Method == "DELETE":
+		delete(stub.objects[collection], parts[1])
+		// Caster deletes a workspace's files with it
+		if collection == "workspaces" {
+			for id, file := range stub.objects["files"] {
+				if file["workspaceId"] == parts[1] {
+					delete(stub.objects["files"], id)
+				}
+			}
+		}
+		w.WriteHeader(stubStatus(topomojoStyle, http.StatusOK, http.StatusNoContent))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// Returns the handler for a call, if one was added, along with the ID in the path of the call
+func (stub *apiStub) findHandler(method string, parts []string) (stubHandler, string, bool) {
+	for key, fn := range stub.handlers {
+		if !strings.HasPrefix(key, method+" ") {
+			continue
+		}
+		pattern := strings.Split(strings.TrimPrefix(key, method+" "), "/")
+		if len(pattern) != len(parts) {
+			continue
+		}
+
+		id := ""
+		matches := true
+		for i, segment := range pattern {
+			if segment == "{id}" {
+				id = parts[i]
+			} else if segment != parts[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return fn, id, true
+		}
+	}
+	return nil, "", false
+}
+
+// Returns the status code for a call to a collection, which depends on the conventions its API follows
+func stubStatus(topomojoStyle bool, topomojoStatus, status int) int {
+	if topomojoStyle {
+		return topomojoStatus
+	}
+	return status
+}
+
+// Returns the field that holds the ID of an object from the given collection in its children, such as scoringModelId
+// for scoringModels
+func stubParentField(collection string) string {
+	if strings.HasSuffix(collection, "ies") {
+		return strings.TrimSuffix(collection, "ies") + "yId"
+	}
+	return strings.TrimSuffix(collection, "s") + "Id"
+}
+
+func writeStubJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The types of value a data field can hold
+var blueprintDataTypes = []string{"String", "Integer", "Decimal", "Boolean", "DateTime", "Organization", "Status", "Team",
+	"TeamsDropdown", "Card", "Url", "Html"}
+
+// A column of a Blueprint MSEL. Scenario events set their value for it in data_values, keyed by the data field's ID.
+func blueprintDataField() *schema.Resource {
+	return &schema.Resource{
+		Create: blueprintDataFieldCreate,
+		Read:   blueprintDataFieldRead,
+		Update: blueprintDataFieldUpdate,
+		Delete: blueprintDataFieldDelete,
+
+		Schema: map[string]*schema.Schema{
+			"msel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "String",
+				ValidateFunc: validation.StringInSlice(blueprintDataTypes, false),
+			},
+			"display_order": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"is_chosen_from_list": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_initially_hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Get data field properties from d
+// Call API to create data field
+// Call read to make sure everything worked
+func blueprintDataFieldCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateBlueprintDataField(blueprintDataFieldFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Blueprint data field created with ID %s", d.Id())
+	return blueprintDataFieldRead(d, m)
+}
+
+// Check if data field exists. If not, set id to "" and return nil
+// Read data field info from API
+// Use it to update local state
+func blueprintDataFieldRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintDataFieldExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	dataField, err := api.ReadBlueprintDataField(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("msel_id", dataField.MselId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", dataField.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("data_type", dataField.DataType)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("display_order", dataField.DisplayOrder)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_chosen_from_list", dataField.IsChosenFromList)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("is_initially_hidden", dataField.IsInitiallyHidden)
+}
+
+// Get data field properties from d
+// Call API to update data field
+// Call read to make sure everything worked
+func blueprintDataFieldUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	dataField := blueprintDataFieldFromConfig(d)
+	dataField.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateBlueprintDataField(dataField, casted)
+	if err != nil {
+		return err
+	}
+
+	return blueprintDataFieldRead(d, m)
+}
+
+// Check if data field exists
+// Call API to delete it
+func blueprintDataFieldDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintDataFieldExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteBlueprintDataField(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a data field struct from the resource's config
+func blueprintDataFieldFromConfig(d *schema.ResourceData) *structs.BlueprintDataField {
+	return &structs.BlueprintDataField{
+		MselId:            d.Get("msel_id").(string),
+		Name:              d.Get("name").(string),
+		DataType:          d.Get("data_type").(string),
+		DisplayOrder:      d.Get("display_order").(int),
+		IsChosenFromList:  d.Get("is_chosen_from_list").(bool),
+		IsInitiallyHidden: d.Get("is_initially_hidden").(bool),
+	}
+}
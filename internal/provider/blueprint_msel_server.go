@@ -0,0 +1,254 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The statuses a MSEL moves through as it is planned, reviewed and run
+var blueprintMselStatuses = []string{"Pending", "Entered", "Approved", "Complete", "Deployed", "Archived"}
+
+// A Master Scenario Events List. Teams, data fields and scenario events are added to it with crucible_blueprint_team,
+// crucible_blueprint_data_field and crucible_blueprint_scenario_event.
+func blueprintMsel() *schema.Resource {
+	return &schema.Resource{
+		Create: blueprintMselCreate,
+		Read:   blueprintMselRead,
+		Update: blueprintMselUpdate,
+		Delete: blueprintMselDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Pending",
+				ValidateFunc: validation.StringInSlice(blueprintMselStatuses, false),
+			},
+			"is_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"player_view_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"use_gallery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"use_cite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"use_steamfitter": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+// Get MSEL properties from d
+// Call API to create MSEL
+// Call read to make sure everything worked
+func blueprintMselCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateBlueprintMsel(blueprintMselFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Blueprint MSEL created with ID %s", d.Id())
+	return blueprintMselRead(d, m)
+}
+
+// Check if MSEL exists. If not, set id to "" and return nil
+// Read MSEL info from API
+// Use it to update local state
+func blueprintMselRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintMselExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	msel, err := api.ReadBlueprintMsel(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", msel.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", msel.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", msel.Status)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_template", msel.IsTemplate)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("player_view_id", msel.PlayerViewId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("use_gallery", msel.UseGallery)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("use_cite", msel.UseCite)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("use_steamfitter", msel.UseSteamfitter)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("start_time", msel.StartTime)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("duration_seconds", msel.DurationSeconds)
+}
+
+// Get MSEL properties from d
+// Call API to update MSEL
+// Call read to make sure everything worked
+func blueprintMselUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	msel := blueprintMselFromConfig(d)
+	msel.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateBlueprintMsel(msel, casted)
+	if err != nil {
+		return err
+	}
+
+	return blueprintMselRead(d, m)
+}
+
+// Check if MSEL exists
+// Call API to delete it. Blueprint deletes the MSEL's teams and scenario events with it.
+func blueprintMselDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintMselExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteBlueprintMsel(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a MSEL struct from the resource's config
+func blueprintMselFromConfig(d *schema.ResourceData) *structs.BlueprintMsel {
+	return &structs.BlueprintMsel{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Status:          d.Get("status").(string),
+		IsTemplate:      d.Get("is_template").(bool),
+		PlayerViewId:    d.Get("player_view_id").(string),
+		UseGallery:      d.Get("use_gallery").(bool),
+		UseCite:         d.Get("use_cite").(bool),
+		UseSteamfitter:  d.Get("use_steamfitter").(bool),
+		StartTime:       d.Get("start_time").(string),
+		DurationSeconds: d.Get("duration_seconds").(int),
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of a MSEL, its teams, data fields and scenario events against a stub of the
+// Blueprint API
+//
+// Execution steps
+// 1. Terraform creates a MSEL with a team, two data fields and a scenario event with a value for each data field
+// 2. Verify local state and the event's data values in the stub
+// 3. Terraform changes the MSEL's status, the team's email, a data field's order and one of the event's values
+// 4. Verify state
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// Resources are created, updated, and destroyed without error, and data values are keyed by data field ID
+func TestBlueprintMsel(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			for _, collection := range []string{"msels", "teams", "dataFields", "scenarioEvents"} {
+				if stub.count(collection) != 0 {
+					return fmt.Errorf("expected no %s after destroy, found %d", collection, stub.count(collection))
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configBlueprintMsel("Pending", "blue@example.com", 2, "BLUE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_blueprint_msel.test", "status", "Pending"),
+					resource.TestCheckResourceAttr("crucible_blueprint_msel.test", "start_time", "2022-03-01T12:00:00Z"),
+					resource.TestCheckResourceAttr("crucible_blueprint_team.test", "short_name", "BLUE"),
+					resource.TestCheckResourceAttr("crucible_blueprint_data_field.to_org", "data_type", "Organization"),
+					resource.TestCheckResourceAttr("crucible_blueprint_scenario_event.test", "data_values.%", "2"),
+					verifyBlueprintDataValue("to_org", "BLUE"),
+				),
+			},
+			{
+				Config: stub.providerConfig() + configBlueprintMsel("Approved", "blue-team@example.com", 3, "BLUE, RED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_blueprint_msel.test", "status", "Approved"),
+					resource.TestCheckResourceAttr("crucible_blueprint_team.test", "email", "blue-team@example.com"),
+					resource.TestCheckResourceAttr("crucible_blueprint_data_field.to_org", "display_order", "3"),
+					verifyBlueprintDataValue("to_org", "BLUE, RED"),
+					verifyBlueprintDataValue("title", "Phishing email"),
+				),
+			},
+		},
+	})
+}
+
+// Checks that the scenario event's value for the given data field resource is expected
+func verifyBlueprintDataValue(field, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fieldID := s.RootModule().Resources["crucible_blueprint_data_field."+field].Primary.ID
+		return resource.TestCheckResourceAttr("crucible_blueprint_scenario_event.test", "data_values."+fieldID, expected)(s)
+	}
+}
+
+func configBlueprintMsel(status, email string, order int, toOrg string) string {
+	return fmt.Sprintf(`
+	resource "crucible_blueprint_msel" "test" {
+		name       = "Exercise"
+		status     = "%s"
+		start_time = "2022-03-01T12:00:00Z"
+	}
+
+	resource "crucible_blueprint_team" "test" {
+		msel_id    = crucible_blueprint_msel.test.id
+		name       = "Blue Team"
+		short_name = "BLUE"
+		email      = "%s"
+	}
+
+	resource "crucible_blueprint_data_field" "title" {
+		msel_id       = crucible_blueprint_msel.test.id
+		name          = "Title"
+		display_order = 1
+	}
+
+	resource "crucible_blueprint_data_field" "to_org" {
+		msel_id             = crucible_blueprint_msel.test.id
+		name                = "To Org"
+		data_type           = "Organization"
+		display_order       = %d
+		is_chosen_from_list = true
+	}
+
+	resource "crucible_blueprint_scenario_event" "test" {
+		msel_id       = crucible_blueprint_msel.test.id
+		group_order   = 1
+		delta_seconds = 1800
+
+		data_values = {
+			(crucible_blueprint_data_field.title.id)  = "Phishing email"
+			(crucible_blueprint_data_field.to_org.id) = "%s"
+		}
+	}
+	`, status, email, order, toOrg)
+}
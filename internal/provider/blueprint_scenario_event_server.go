@@ -0,0 +1,244 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// An event in a MSEL. The event's columns, such as its title or the team it goes to, are set in data_values by the
+// ID of the MSEL's data field.
+func blueprintScenarioEvent() *schema.Resource {
+	return &schema.Resource{
+		Create: blueprintScenarioEventCreate,
+		Read:   blueprintScenarioEventRead,
+		Update: blueprintScenarioEventUpdate,
+		Delete: blueprintScenarioEventDelete,
+
+		Schema: map[string]*schema.Schema{
+			"msel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_order": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"delta_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"event_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Inject",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"data_values": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// Get scenario event properties from d
+// Call API to create scenario event
+// Call read to make sure everything worked
+func blueprintScenarioEventCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateBlueprintScenarioEvent(blueprintScenarioEventFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Blueprint scenario event created with ID %s", d.Id())
+	return blueprintScenarioEventRead(d, m)
+}
+
+// Check if scenario event exists. If not, set id to "" and return nil
+// Read scenario event info from API
+// Use it to update local state
+func blueprintScenarioEventRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintScenarioEventExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	event, err := api.ReadBlueprintScenarioEvent(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("msel_id", event.MselId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("group_order", event.GroupOrder)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("delta_seconds", event.DeltaSeconds)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("event_type", event.ScenarioEventType)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", event.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_hidden", event.IsHidden)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("data_values", blueprintDataValuesToMap(event.DataValues))
+}
+
+// Get scenario event properties from d
+// Call API to update scenario event
+// Call read to make sure everything worked
+func blueprintScenarioEventUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	event := blueprintScenarioEventFromConfig(d)
+	event.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateBlueprintScenarioEvent(event, casted)
+	if err != nil {
+		return err
+	}
+
+	return blueprintScenarioEventRead(d, m)
+}
+
+// Check if scenario event exists
+// Call API to delete it
+func blueprintScenarioEventDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintScenarioEventExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteBlueprintScenarioEvent(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a scenario event struct from the resource's config
+func blueprintScenarioEventFromConfig(d *schema.ResourceData) *structs.BlueprintScenarioEvent {
+	return &structs.BlueprintScenarioEvent{
+		MselId:            d.Get("msel_id").(string),
+		GroupOrder:        d.Get("group_order").(int),
+		DeltaSeconds:      d.Get("delta_seconds").(int),
+		ScenarioEventType: d.Get("event_type").(string),
+		Description:       d.Get("description").(string),
+		IsHidden:          d.Get("is_hidden").(bool),
+		DataValues:        blueprintDataValuesFromConfig(d),
+	}
+}
+
+// Returns the data values set in config. The keys of data_values are data field IDs.
+func blueprintDataValuesFromConfig(d *schema.ResourceData) []structs.BlueprintDataValue {
+	values := []structs.BlueprintDataValue{}
+	for fieldID, value := range d.Get("data_values").(map[string]interface{}) {
+		values = append(values, structs.BlueprintDataValue{
+			DataFieldId: fieldID,
+			Value:       value.(string),
+		})
+	}
+	return values
+}
+
+// Converts data values to the map stored in data_values. Blank values are left out, as Blueprint returns a value
+// for every data field of the MSEL.
+func blueprintDataValuesToMap(values []structs.BlueprintDataValue) map[string]string {
+	asMap := make(map[string]string)
+	for _, value := range values {
+		if value.Value != "" {
+			asMap[value.DataFieldId] = value.Value
+		}
+	}
+	return asMap
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func blueprintTeam() *schema.Resource {
+	return &schema.Resource{
+		Create: blueprintTeamCreate,
+		Read:   blueprintTeamRead,
+		Update: blueprintTeamUpdate,
+		Delete: blueprintTeamDelete,
+
+		Schema: map[string]*schema.Schema{
+			"msel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"short_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Get team properties from d
+// Call API to create team
+// Call read to make sure everything worked
+func blueprintTeamCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateBlueprintTeam(blueprintTeamFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Blueprint team created with ID %s", d.Id())
+	return blueprintTeamRead(d, m)
+}
+
+// Check if team exists. If not, set id to "" and return nil
+// Read team info from API
+// Use it to update local state
+func blueprintTeamRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintTeamExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	team, err := api.ReadBlueprintTeam(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("msel_id", team.MselId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", team.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("short_name", team.ShortName)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("email", team.Email)
+}
+
+// Get team properties from d
+// Call API to update team
+// Call read to make sure everything worked
+func blueprintTeamUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	team := blueprintTeamFromConfig(d)
+	team.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateBlueprintTeam(team, casted)
+	if err != nil {
+		return err
+	}
+
+	return blueprintTeamRead(d, m)
+}
+
+// Check if team exists
+// Call API to delete it
+func blueprintTeamDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "blueprint_api_url", "Blueprint")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.BlueprintTeamExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteBlueprintTeam(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a team struct from the resource's config
+func blueprintTeamFromConfig(d *schema.ResourceData) *structs.BlueprintTeam {
+	return &structs.BlueprintTeam{
+		MselId:    d.Get("msel_id").(string),
+		Name:      d.Get("name").(string),
+		ShortName: d.Get("short_name").(string),
+		Email:     d.Get("email").(string),
+	}
+}
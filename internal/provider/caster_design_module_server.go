@@ -0,0 +1,189 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func casterDesignModule() *schema.Resource {
+	return &schema.Resource{
+		Create: casterDesignModuleCreate,
+		Read:   casterDesignModuleRead,
+		Update: casterDesignModuleUpdate,
+		Delete: casterDesignModuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"design_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"module_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// Get design module properties from d
+// Call API to add the module to the design
+// Call read to make sure everything worked
+func casterDesignModuleCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterDesignModule(casterDesignModuleFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster design module created with ID %s", d.Id())
+	return casterDesignModuleRead(d, m)
+}
+
+// Check if design module exists. If not, set id to "" and return nil
+// Read design module info from API
+// Use it to update local state
+func casterDesignModuleRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterDesignModuleExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	designModule, err := api.ReadCasterDesignModule(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("design_id", designModule.DesignId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("module_id", designModule.ModuleId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", designModule.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("module_version", designModule.ModuleVersion)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("variables", designModule.Variables)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("enabled", designModule.Enabled)
+}
+
+// Get design module properties from d
+// Call API to update design module
+// Call read to make sure everything worked
+func casterDesignModuleUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	designModule := casterDesignModuleFromConfig(d)
+	designModule.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := api.UpdateCasterDesignModule(designModule, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterDesignModuleRead(d, m)
+}
+
+// Check if design module exists
+// Call API to remove it from the design
+func casterDesignModuleDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterDesignModuleExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCasterDesignModule(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a design module struct from the resource's config
+func casterDesignModuleFromConfig(d *schema.ResourceData) *structs.CasterDesignModule {
+	variables := make(map[string]string)
+	for name, value := range d.Get("variables").(map[string]interface{}) {
+		variables[name] = value.(string)
+	}
+
+	return &structs.CasterDesignModule{
+		DesignId:      d.Get("design_id").(string),
+		ModuleId:      d.Get("module_id").(string),
+		Name:          d.Get("name").(string),
+		ModuleVersion: d.Get("module_version").(string),
+		Variables:     variables,
+		Enabled:       d.Get("enabled").(bool),
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func casterDesign() *schema.Resource {
+	return &schema.Resource{
+		Create: casterDesignCreate,
+		Read:   casterDesignRead,
+		Update: casterDesignUpdate,
+		Delete: casterDesignDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// Get design properties from d
+// Call API to create design
+// Call read to make sure everything worked
+func casterDesignCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	design := &structs.CasterDesign{
+		ProjectId: d.Get("project_id").(string),
+		Name:      d.Get("name").(string),
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterDesign(design, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster design created with ID %s", d.Id())
+	return casterDesignRead(d, m)
+}
+
+// Check if design exists. If not, set id to "" and return nil
+// Read design info from API
+// Use it to update local state
+func casterDesignRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterDesignExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	design, err := api.ReadCasterDesign(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("project_id", design.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("name", design.Name)
+}
+
+// Get design properties from d
+// Call API to update design
+// Call read to make sure everything worked
+func casterDesignUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	design := &structs.CasterDesign{
+		Id:        d.Id(),
+		ProjectId: d.Get("project_id").(string),
+		Name:      d.Get("name").(string),
+	}
+
+	casted := m.(map[string]string)
+	err := api.UpdateCasterDesign(design, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterDesignRead(d, m)
+}
+
+// Check if design exists
+// Call API to delete it
+func casterDesignDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterDesignExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCasterDesign(id, casted)
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func casterDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: casterDirectoryCreate,
+		Read:   casterDirectoryRead,
+		Update: casterDirectoryUpdate,
+		Delete: casterDirectoryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"terraform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Get directory properties from d
+// Call API to create directory
+// Call read to make sure everything worked
+func casterDirectoryCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	directory := casterDirectoryFromConfig(d)
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterDirectory(directory, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster directory created with ID %s", d.Id())
+	return casterDirectoryRead(d, m)
+}
+
+// Check if directory exists. If not, set id to "" and return nil
+// Read directory info from API
+// Use it to update local state
+func casterDirectoryRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterDirectoryExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	directory, err := api.ReadCasterDirectory(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("project_id", directory.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("parent_id", directory.ParentId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", directory.Name)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("terraform_version", directory.TerraformVersion)
+}
+
+// Get directory properties from d
+// Call API to update directory
+// Call read to make sure everything worked
+func casterDirectoryUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	directory := casterDirectoryFromConfig(d)
+	directory.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := api.UpdateCasterDirectory(directory, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterDirectoryRead(d, m)
+}
+
+// Check if directory exists
+// Call API to delete it
+func casterDirectoryDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterDirectoryExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCasterDirectory(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a directory struct from the resource's config
+func casterDirectoryFromConfig(d *schema.ResourceData) *structs.CasterDirectory {
+	return &structs.CasterDirectory{
+		ProjectId:        d.Get("project_id").(string),
+		ParentId:         d.Get("parent_id").(string),
+		Name:             d.Get("name").(string),
+		TerraformVersion: d.Get("terraform_version").(string),
+	}
+}
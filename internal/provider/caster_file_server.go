@@ -0,0 +1,244 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"io/ioutil"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The content of a file comes from either the content attribute or a local file at source. Changes are detected by
+// comparing the SHA-256 hash of the desired content with the hash of the content in Caster. Updates and deletes wait
+// while the file's workspace is locked by a run.
+func casterFile() *schema.Resource {
+	return &schema.Resource{
+		Create:        casterFileCreate,
+		Read:          casterFileRead,
+		Update:        casterFileUpdate,
+		Delete:        casterFileDelete,
+		CustomizeDiff: casterFileCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"content", "source"},
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Get file properties from d
+// Call API to create file
+// Call read to make sure everything worked
+func casterFileCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	file, err := casterFileFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterFile(file, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster file created with ID %s", d.Id())
+	return casterFileRead(d, m)
+}
+
+// Check if file exists. If not, set id to "" and return nil
+// Read file info from API
+// Use it to update local state
+func casterFileRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterFileExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	file, err := api.ReadCasterFile(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("directory_id", file.DirectoryId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("workspace_id", file.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", file.Name)
+	if err != nil {
+		return err
+	}
+
+	// Content read from source is only tracked by its hash
+	if _, ok := d.GetOk("content"); ok {
+		err = d.Set("content", file.Content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.Set("content_sha256", casterFileHash(file.Content))
+}
+
+// Get file properties from d
+// Call API to update file, waiting while its workspace is locked
+// Call read to make sure everything worked
+func casterFileUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	file, err := casterFileFromConfig(d)
+	if err != nil {
+		return err
+	}
+	file.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err = retryWhileCasterLocked(d.Timeout(schema.TimeoutUpdate), "file "+file.Id, "", func() error {
+		return api.UpdateCasterFile(file, casted)
+	})
+	if err != nil {
+		return err
+	}
+
+	return casterFileRead(d, m)
+}
+
+// Check if file exists
+// Call API to delete it, waiting while its workspace is locked
+func casterFileDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterFileExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return retryWhileCasterLocked(d.Timeout(schema.TimeoutDelete), "file "+id, "", func() error {
+		return api.DeleteCasterFile(id, casted)
+	})
+}
+
+// Plan an update when the hash of the desired content differs from the hash of the content in Caster. This is how
+// changes to the local file at source are detected.
+func casterFileCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("content") || !d.NewValueKnown("source") {
+		return d.SetNewComputed("content_sha256")
+	}
+
+	content, err := casterFileContent(d.Get("content").(string), d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+
+	hash := casterFileHash(content)
+	if d.Get("content_sha256").(string) != hash {
+		return d.SetNew("content_sha256", hash)
+	}
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a file struct from the resource's config, reading its content from source if set
+func casterFileFromConfig(d *schema.ResourceData) (*structs.CasterFile, error) {
+	content, err := casterFileContent(d.Get("content").(string), d.Get("source").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &structs.CasterFile{
+		DirectoryId: d.Get("directory_id").(string),
+		WorkspaceId: d.Get("workspace_id").(string),
+		Name:        d.Get("name").(string),
+		Content:     content,
+	}, nil
+}
+
+// Returns the inline content of a file, or the content of the local file at source if it is set
+func casterFileContent(content, source string) (string, error) {
+	if source == "" {
+		return content, nil
+	}
+
+	asBytes, err := ioutil.ReadFile(source)
+	if err != nil {
+		return "", fmt.Errorf("error reading source of caster file: %v", err)
+	}
+	return string(asBytes), nil
+}
+
+// Returns the hex encoded SHA-256 hash of a file's content
+func casterFileHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for changing a file while a simulated Caster run holds the lock on its workspace
+//
+// Execution steps
+// 1. Terraform creates a workspace and a file in it, then the stub locks the workspace
+// 2. Terraform changes the file's content with a short update timeout
+// 3. Verify the update fails with a message saying the file is locked
+// 4. Terraform changes the content again while the stub releases the lock after a second
+// 5. Verify the update waited for the lock and succeeded
+// 6. Terraform destroys the file
+//
+// Expected behavior:
+// Updates wait for the lock, and fail with a clear error if it isn't released in time
+func TestCasterFileLocked(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var workspaceID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("files") != 0 || stub.count("workspaces") != 0 {
+				return fmt.Errorf("expected no files or workspaces after destroy, found %d and %d",
+					stub.count("files"), stub.count("workspaces"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configCasterLockedFile("first", "2s"),
+				Check: func(s *terraform.State) error {
+					workspaceID = s.RootModule().Resources["crucible_caster_workspace.test"].Primary.ID
+					stub.setLocked(workspaceID, true)
+					return nil
+				},
+			},
+			{
+				Config:      stub.providerConfig() + configCasterLockedFile("second", "2s"),
+				ExpectError: regexp.MustCompile("is still locked by a Caster run in progress"),
+			},
+			{
+				PreConfig: func() {
+					go func() {
+						time.Sleep(time.Second)
+						stub.setLocked(workspaceID, false)
+					}()
+				},
+				Config: stub.providerConfig() + configCasterLockedFile("second", "1m"),
+				Check:  resource.TestCheckResourceAttr("crucible_caster_file.test", "content", "second"),
+			},
+		},
+	})
+}
+
+func configCasterLockedFile(content, timeout string) string {
+	return fmt.Sprintf(`
+	resource "crucible_caster_workspace" "test" {
+		directory_id = "5b9e7c1c-6bd0-4e8a-9d0e-2d7a1c3f4e21"
+		name         = "range"
+	}
+
+	resource "crucible_caster_file" "test" {
+		directory_id = "5b9e7c1c-6bd0-4e8a-9d0e-2d7a1c3f4e21"
+		workspace_id = crucible_caster_workspace.test.id
+		name         = "main.tf"
+		content      = "%s"
+
+		timeouts {
+			update = "%s"
+			delete = "%s"
+		}
+	}
+	`, content, timeout, timeout)
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A project can have several hosts, so the ID of this resource is the project ID and host ID joined by a slash
+func casterHostAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: casterHostAssignmentCreate,
+		Read:   casterHostAssignmentRead,
+		Delete: casterHostAssignmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// Call API to assign the host to the project
+// Call read to make sure everything worked
+func casterHostAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	projectID := d.Get("project_id").(string)
+	hostID := d.Get("host_id").(string)
+	casted := m.(map[string]string)
+
+	err := api.AssignCasterHost(projectID, hostID, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(projectID + "/" + hostID)
+
+	log.Printf("! Host %s assigned to project %s", hostID, projectID)
+	return casterHostAssignmentRead(d, m)
+}
+
+// Read the project's hosts from the API
+// If the host is no longer assigned to the project, set id to "" and return nil
+func casterHostAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	split := strings.Split(d.Id(), "/")
+	if len(split) != 2 {
+		return fmt.Errorf("invalid host assignment ID %s, expected <project_id>/<host_id>", d.Id())
+	}
+	projectID, hostID := split[0], split[1]
+	casted := m.(map[string]string)
+
+	hosts, err := api.ListCasterHosts(projectID, casted)
+	if err != nil {
+		return err
+	}
+
+	assigned := false
+	for _, host := range hosts {
+		if host.Id == hostID {
+			assigned = true
+		}
+	}
+	if !assigned {
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("project_id", projectID)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("host_id", hostID)
+}
+
+// Call API to remove the host from the project
+func casterHostAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	return api.UnassignCasterHost(d.Get("project_id").(string), d.Get("host_id").(string), casted)
+}
@@ -0,0 +1,286 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func casterHost() *schema.Resource {
+	return &schema.Resource{
+		Create: casterHostCreate,
+		Read:   casterHostRead,
+		Update: casterHostUpdate,
+		Delete: casterHostDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datastore": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"maximum_machines": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"development": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Get host properties from d
+// Call API to create host
+// Call read to make sure everything worked
+func casterHostCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterHost(casterHostFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster host created with ID %s", d.Id())
+	return casterHostRead(d, m)
+}
+
+// Check if host exists. If not, set id to "" and return nil
+// Read host info from API
+// Use it to update local state
+func casterHostRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterHostExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	host, err := api.ReadCasterHost(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", host.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("datastore", host.Datastore)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("maximum_machines", host.MaximumMachines)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("enabled", host.Enabled)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("development", host.Development)
+}
+
+// Get host properties from d
+// Call API to update host
+// Call read to make sure everything worked
+func casterHostUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	host := casterHostFromConfig(d)
+	host.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := api.UpdateCasterHost(host, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterHostRead(d, m)
+}
+
+// Check if host exists
+// Call API to delete it
+func casterHostDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterHostExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCasterHost(id, casted)
+}
+
+// Lists hosts and how many more machines each can take
+func casterHostsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: casterHostsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datastore": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maximum_machines": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"machine_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"available_machines": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"development": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"available_machines": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read the hosts from the API and total the machines the enabled ones can still take
+func casterHostsDataSourceRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	projectID := d.Get("project_id").(string)
+	casted := m.(map[string]string)
+
+	hosts, err := api.ListCasterHosts(projectID, casted)
+	if err != nil {
+		return err
+	}
+
+	if projectID != "" {
+		d.SetId(projectID)
+	} else {
+		d.SetId("hosts")
+	}
+
+	total := 0
+	hostList := []interface{}{}
+	for _, host := range hosts {
+		available := host.MaximumMachines - host.MachineCount
+		if available < 0 {
+			available = 0
+		}
+		if host.Enabled {
+			total += available
+		}
+
+		hostList = append(hostList, map[string]interface{}{
+			"id":                 host.Id,
+			"name":               host.Name,
+			"datastore":          host.Datastore,
+			"maximum_machines":   host.MaximumMachines,
+			"machine_count":      host.MachineCount,
+			"available_machines": available,
+			"enabled":            host.Enabled,
+			"development":        host.Development,
+			"project_id":         host.ProjectId,
+		})
+	}
+
+	err = d.Set("hosts", hostList)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("available_machines", total)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a host struct from the resource's config
+func casterHostFromConfig(d *schema.ResourceData) *structs.CasterHost {
+	return &structs.CasterHost{
+		Name:            d.Get("name").(string),
+		Datastore:       d.Get("datastore").(string),
+		MaximumMachines: d.Get("maximum_machines").(int),
+		Enabled:         d.Get("enabled").(bool),
+		Development:     d.Get("development").(bool),
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func casterModule() *schema.Resource {
+	return &schema.Resource{
+		Create: casterModuleCreate,
+		Read:   casterModuleRead,
+		Update: casterModuleUpdate,
+		Delete: casterModuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Get module properties from d
+// Call API to create module
+// Call read to make sure everything worked
+func casterModuleCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterModule(casterModuleFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster module created with ID %s", d.Id())
+	return casterModuleRead(d, m)
+}
+
+// Check if module exists. If not, set id to "" and return nil
+// Read module info from API
+// Use it to update local state
+func casterModuleRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterModuleExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	module, err := api.ReadCasterModule(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", module.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("source", module.Source)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("version", module.Version)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("description", module.Description)
+}
+
+// Get module properties from d
+// Call API to update module
+// Call read to make sure everything worked
+func casterModuleUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	module := casterModuleFromConfig(d)
+	module.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := api.UpdateCasterModule(module, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterModuleRead(d, m)
+}
+
+// Check if module exists
+// Call API to delete it
+func casterModuleDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterModuleExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCasterModule(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a module struct from the resource's config
+func casterModuleFromConfig(d *schema.ResourceData) *structs.CasterModule {
+	return &structs.CasterModule{
+		Name:        d.Get("name").(string),
+		Source:      d.Get("source").(string),
+		Version:     d.Get("version").(string),
+		Description: d.Get("description").(string),
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func casterProject() *schema.Resource {
+	return &schema.Resource{
+		Create: casterProjectCreate,
+		Read:   casterProjectRead,
+		Update: casterProjectUpdate,
+		Delete: casterProjectDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// Get project properties from d
+// Call API to create project
+// Call read to make sure everything worked
+func casterProjectCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	project := &structs.CasterProject{
+		Name: d.Get("name").(string),
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterProject(project, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster project created with ID %s", d.Id())
+	return casterProjectRead(d, m)
+}
+
+// Check if project exists. If not, set id to "" and return nil
+// Read project info from API
+// Use it to update local state
+func casterProjectRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterProjectExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	project, err := api.ReadCasterProject(id, casted)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("name", project.Name)
+}
+
+// Get project properties from d
+// Call API to update project
+// Call read to make sure everything worked
+func casterProjectUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	project := &structs.CasterProject{
+		Id:   d.Id(),
+		Name: d.Get("name").(string),
+	}
+
+	casted := m.(map[string]string)
+	err := api.UpdateCasterProject(project, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterProjectRead(d, m)
+}
+
+// Check if project exists
+// Call API to delete it
+func casterProjectDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterProjectExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCasterProject(id, casted)
+}
@@ -0,0 +1,262 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// Statuses a run can end in without being applied
+var casterRunFailedStatuses = []string{"Failed", "Rejected", "AppliedStateError", "FailedStateError"}
+
+// A run of Terraform in a Caster workspace. Creating this resource queues a run and waits for it to finish. Changing
+// any of its arguments other than poll_interval and output_lines queues a new run.
+func casterRun() *schema.Resource {
+	return &schema.Resource{
+		Create: casterRunCreate,
+		Read:   casterRunRead,
+		Update: casterRunUpdate,
+		Delete: casterRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"auto_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"poll_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "10s",
+				ValidateFunc: util.ValidateDuration,
+			},
+			"output_lines": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plan_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"apply_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Call API to queue a run, then wait for it to be planned
+// If auto_apply is set, apply the run and wait for it to be applied
+// If the run fails, return an error with its output. The run stays in state so it is tainted and replaced on the next
+// apply
+func casterRunCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	run, err := api.CreateCasterRun(d.Get("workspace_id").(string), d.Get("is_destroy").(bool), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(run.Id)
+	log.Printf("! Caster run queued with ID %s", d.Id())
+
+	run, err = waitForCasterRun(d, []string{"Queued", "Planning"}, deadline, casted)
+	if err != nil {
+		return err
+	}
+
+	if run.Status == "Planned" && d.Get("auto_apply").(bool) {
+		err = api.ApplyCasterRun(d.Id(), casted)
+		if err != nil {
+			return err
+		}
+
+		run, err = waitForCasterRun(d, []string{"Planned", "Applying"}, deadline, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = setCasterRunState(d, run)
+	if err != nil {
+		return err
+	}
+
+	if util.StrSliceContains(&casterRunFailedStatuses, run.Status) {
+		return casterRunFailure(d, run, casted)
+	}
+
+	return casterRunRead(d, m)
+}
+
+// Check if run exists. If not, set id to "" and return nil
+// Read run info from API
+// Use it to update local state
+func casterRunRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterRunExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	run, err := api.ReadCasterRun(id, casted)
+	if err != nil {
+		return err
+	}
+
+	return setCasterRunState(d, run)
+}
+
+// Only poll_interval and output_lines can change without a new run, and they are only used during create
+func casterRunUpdate(d *schema.ResourceData, m interface{}) error {
+	return casterRunRead(d, m)
+}
+
+// Runs can't be deleted from Caster, so this only removes the run from state. To destroy the resources a workspace
+// created, use a run with is_destroy set
+func casterRunDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	log.Printf("! Removing Caster run %s from state", d.Id())
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Waits until the run is no longer in one of the pending statuses, polling every poll_interval until the deadline
+func waitForCasterRun(d *schema.ResourceData, pending []string, deadline time.Time, m map[string]string) (*structs.CasterRun, error) {
+	// This has already been validated by the schema
+	interval, _ := time.ParseDuration(d.Get("poll_interval").(string))
+	id := d.Id()
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"done"},
+		Timeout:      time.Until(deadline),
+		PollInterval: interval,
+		Refresh: func() (interface{}, string, error) {
+			run, err := api.ReadCasterRun(id, m)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("! Waiting on Caster run %s, status is %s", id, run.Status)
+			if util.StrSliceContains(&pending, run.Status) {
+				return run, "pending", nil
+			}
+			return run, "done", nil
+		},
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for Caster run %s: %v", id, err)
+	}
+	return result.(*structs.CasterRun), nil
+}
+
+// Returns an error describing a failed run, including the last output_lines lines of its output
+func casterRunFailure(d *schema.ResourceData, run *structs.CasterRun, m map[string]string) error {
+	message := fmt.Sprintf("Caster run %s in workspace %s finished with status %s", run.Id, run.WorkspaceId, run.Status)
+
+	lines := d.Get("output_lines").(int)
+	if lines == 0 {
+		return fmt.Errorf("%s", message)
+	}
+
+	output, err := api.GetCasterRunOutput(run, m)
+	if err != nil {
+		return fmt.Errorf("%s. Its output could not be read: %v", message, err)
+	}
+
+	split := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(split) > lines {
+		split = split[len(split)-lines:]
+	}
+
+	return fmt.Errorf("%s. Output:\n%s", message, strings.Join(split, "\n"))
+}
+
+// Sets the computed attributes of a run in state
+func setCasterRunState(d *schema.ResourceData, run *structs.CasterRun) error {
+	err := d.Set("workspace_id", run.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_destroy", run.IsDestroy)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", run.Status)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("plan_id", run.PlanId)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("apply_id", run.ApplyId)
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A project can only be assigned to one partition at a time, so the ID of this resource is the ID of the project
+func casterVlanPartitionAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: casterVlanPartitionAssignmentCreate,
+		Read:   casterVlanPartitionAssignmentRead,
+		Delete: casterVlanPartitionAssignmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// Call API to assign the partition to the project
+// Call read to make sure everything worked
+func casterVlanPartitionAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	projectID := d.Get("project_id").(string)
+	partitionID := d.Get("partition_id").(string)
+	casted := m.(map[string]string)
+
+	err := api.AssignPartitionToProject(projectID, partitionID, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(projectID)
+
+	log.Printf("! Partition %s assigned to project %s", partitionID, projectID)
+	return casterVlanPartitionAssignmentRead(d, m)
+}
+
+// Read the project's partition from the API
+// If the project no longer exists or has no partition, set id to "" and return nil
+func casterVlanPartitionAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+
+	partitionID, err := api.ReadProjectPartition(d.Id(), casted)
+	if err != nil {
+		return err
+	}
+	if partitionID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("project_id", d.Id())
+	if err != nil {
+		return err
+	}
+
+	return d.Set("partition_id", partitionID)
+}
+
+// Call API to return the project to the default partition
+func casterVlanPartitionAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	return api.UnassignProjectPartition(d.Id(), casted)
+}
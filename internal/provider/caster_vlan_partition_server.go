@@ -0,0 +1,167 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func casterVlanPartition() *schema.Resource {
+	return &schema.Resource{
+		Create: casterVlanPartitionCreate,
+		Read:   casterVlanPartitionRead,
+		Update: casterVlanPartitionUpdate,
+		Delete: casterVlanPartitionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"vlan_range": vlanRangeSchema(),
+		},
+	}
+}
+
+// Get partition properties from d
+// Call API to create partition
+// Call read to make sure everything worked
+func casterVlanPartitionCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	ranges, err := vlanRangesFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	partition := &structs.VlanPartition{
+		PoolId:    d.Get("pool_id").(string),
+		Name:      d.Get("name").(string),
+		IsDefault: d.Get("is_default").(bool),
+		Ranges:    ranges,
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateVlanPartition(partition, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Vlan partition created with ID %s", d.Id())
+	return casterVlanPartitionRead(d, m)
+}
+
+// Check if partition exists. If not, set id to "" and return nil
+// Read partition info from API
+// Use it to update local state
+func casterVlanPartitionRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.VlanPartitionExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	partition, err := api.ReadVlanPartition(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("pool_id", partition.PoolId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", partition.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_default", partition.IsDefault)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("vlan_range", vlanRangesToList(partition.Ranges))
+}
+
+// Get partition properties from d
+// Call API to update partition
+// Call read to make sure everything worked
+func casterVlanPartitionUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	ranges, err := vlanRangesFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	partition := &structs.VlanPartition{
+		Id:        d.Id(),
+		PoolId:    d.Get("pool_id").(string),
+		Name:      d.Get("name").(string),
+		IsDefault: d.Get("is_default").(bool),
+		Ranges:    ranges,
+	}
+
+	casted := m.(map[string]string)
+	err = api.UpdateVlanPartition(partition, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterVlanPartitionRead(d, m)
+}
+
+// Check if partition exists
+// Call API to delete it
+func casterVlanPartitionDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.VlanPartitionExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteVlanPartition(id, casted)
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func casterVlanPool() *schema.Resource {
+	return &schema.Resource{
+		Create: casterVlanPoolCreate,
+		Read:   casterVlanPoolRead,
+		Update: casterVlanPoolUpdate,
+		Delete: casterVlanPoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vlan_range": vlanRangeSchema(),
+		},
+	}
+}
+
+// Schema for a list of inclusive VLAN ID ranges, shared by pools and partitions
+func vlanRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+				"end": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+			},
+		},
+	}
+}
+
+// Get pool properties from d
+// Call API to create pool
+// Call read to make sure everything worked
+func casterVlanPoolCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	ranges, err := vlanRangesFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	pool := &structs.VlanPool{
+		Name:   d.Get("name").(string),
+		Ranges: ranges,
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateVlanPool(pool, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Vlan pool created with ID %s", d.Id())
+	return casterVlanPoolRead(d, m)
+}
+
+// Check if pool exists. If not, set id to "" and return nil
+// Read pool info from API
+// Use it to update local state
+func casterVlanPoolRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.VlanPoolExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	pool, err := api.ReadVlanPool(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", pool.Name)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("vlan_range", vlanRangesToList(pool.Ranges))
+}
+
+// Get pool properties from d
+// Call API to update pool
+// Call read to make sure everything worked
+func casterVlanPoolUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	ranges, err := vlanRangesFromConfig(d)
+	if err != nil {
+		return err
+	}
+
+	pool := &structs.VlanPool{
+		Id:     d.Id(),
+		Name:   d.Get("name").(string),
+		Ranges: ranges,
+	}
+
+	casted := m.(map[string]string)
+	err = api.UpdateVlanPool(pool, casted)
+	if err != nil {
+		return err
+	}
+
+	return casterVlanPoolRead(d, m)
+}
+
+// Check if pool exists
+// Call API to delete it
+func casterVlanPoolDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.VlanPoolExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteVlanPool(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Reads and validates the vlan_range blocks of a pool or partition
+func vlanRangesFromConfig(d *schema.ResourceData) ([]structs.VlanRange, error) {
+	ranges := structs.VlanRangesFromList(d.Get("vlan_range").([]interface{}))
+	for _, vlanRange := range ranges {
+		if vlanRange.Start > vlanRange.End {
+			return nil, fmt.Errorf("vlan_range start %d is greater than its end %d", vlanRange.Start, vlanRange.End)
+		}
+	}
+	return ranges, nil
+}
+
+// Converts VLAN ranges to a list that can be set in state
+func vlanRangesToList(ranges []structs.VlanRange) []interface{} {
+	list := []interface{}{}
+	for _, vlanRange := range ranges {
+		list = append(list, vlanRange.ToMap())
+	}
+	return list
+}
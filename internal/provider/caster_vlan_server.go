@@ -9,6 +9,7 @@ import (
 	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
 	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -17,8 +18,17 @@ func casterVlan() *schema.Resource {
 	return &schema.Resource{
 		Create: casterVlanCreate,
 		Read:   casterVlanRead,
+		Update: casterVlanUpdate,
 		Delete: casterVlanDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: casterVlanImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"partition_id": {
 				Type:          schema.TypeString,
@@ -39,7 +49,11 @@ func casterVlan() *schema.Resource {
 			"tag": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+			},
+			"reserved": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"vlan_id": {
@@ -81,6 +95,15 @@ func casterVlanCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(vlan.Id)
 
+	// Acquiring a vlan can't reserve it, so that takes a second call
+	reserved, reservedExists := d.GetOkExists("reserved")
+	if reservedExists && reserved.(bool) != vlan.Reserved {
+		vlan, err = api.UpdateVlan(vlan.Id, vlan.Tag, reserved.(bool), casted)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = d.Set("vlan_id", vlan.VlanId)
 	if err != nil {
 		return err
@@ -107,6 +130,11 @@ func casterVlanCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = d.Set("reserved", vlan.Reserved)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("! Vlan created with ID %s", d.Id())
 	return nil
 }
@@ -150,9 +178,54 @@ func casterVlanRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = d.Set("reserved", vlan.Reserved)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// Update the tag and reserved flag of the vlan in place
+// Any other change forces a new vlan to be acquired
+func casterVlanUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	_, err := api.UpdateVlan(id, d.Get("tag").(string), d.Get("reserved").(bool), casted)
+	if err != nil {
+		return err
+	}
+
+	return casterVlanRead(d, m)
+}
+
+// Adopt a vlan that has already been acquired, by its ID
+// A vlan that is not in use has to be acquired with a new resource instead
+func casterVlanImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if m == nil {
+		return nil, fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	vlan, err := api.ReadVlan(id, casted)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vlan.InUse {
+		return nil, fmt.Errorf("vlan %s (VLAN ID %d) is not in use, so it cannot be imported. Acquire it with a new crucible_vlan resource instead", id, vlan.VlanId)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Delete vlan
 // Call API release function. Return nil on success or some error on failure
 func casterVlanDelete(d *schema.ResourceData, m interface{}) error {
@@ -163,5 +236,8 @@ func casterVlanDelete(d *schema.ResourceData, m interface{}) error {
 	id := d.Id()
 	casted := m.(map[string]string)
 
-	return api.DeleteVlan(id, casted)
+	// Caster won't release a vlan while a run using it is in progress
+	return retryWhileCasterLocked(d.Timeout(schema.TimeoutDelete), "vlan "+id, "", func() error {
+		return api.DeleteVlan(id, casted)
+	})
 }
@@ -0,0 +1,400 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A group of VLANs acquired and released together. Each VLAN in the set is identified by a tag made of
+// tag_prefix followed by its index, starting at 0.
+func casterVlanSet() *schema.Resource {
+	return &schema.Resource{
+		Create: casterVlanSetCreate,
+		Read:   casterVlanSetRead,
+		Update: casterVlanSetUpdate,
+		Delete: casterVlanSetDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"partition_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project_id"},
+				ForceNew:      true,
+				Computed:      true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4096),
+			},
+			"tag_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			// Tag to the numeric VLAN ID
+			"vlans": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			// Tag to the Caster ID of the VLAN, needed to release it
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// Acquire every VLAN in the set
+// If any can't be acquired, release the ones that were and return an error
+// Otherwise set local state and call read
+func casterVlanSetCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	prefix := d.Get("tag_prefix").(string)
+
+	tags := []string{}
+	for i := 0; i < d.Get("size").(int); i++ {
+		tags = append(tags, vlanSetTag(prefix, i))
+	}
+
+	acquired, err := acquireVlanSet(d, tags, casted)
+	if err != nil {
+		// Don't leave VLANs marked as in use that nothing is tracking
+		ids := make(map[string]string)
+		for tag, vlan := range acquired {
+			ids[tag] = vlan.Id
+		}
+		_, releaseErr := releaseVlanSet(d, ids, d.Timeout(schema.TimeoutCreate), casted)
+		if releaseErr != nil {
+			return fmt.Errorf("%v. Additionally, failed to release the VLANs that were acquired: %v", err, releaseErr)
+		}
+		return err
+	}
+
+	d.SetId(uuid.NewString())
+
+	err = setVlanSetState(d, acquired)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! Vlan set created with ID %s and %d vlans", d.Id(), len(acquired))
+	return casterVlanSetRead(d, m)
+}
+
+// Read every VLAN in the set from the API
+// VLANs that are no longer in use are dropped, and size is lowered to match so the next plan re-acquires them
+func casterVlanSetRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	ids := vlanSetIDs(d)
+
+	tags := make([]string, 0, len(ids))
+	for tag := range ids {
+		tags = append(tags, tag)
+	}
+
+	vlans := make([]*structs.Vlan, len(tags))
+	errs := util.ForEachConcurrently(len(tags), d.Get("concurrency").(int), func(i int) error {
+		vlan, err := api.ReadVlan(ids[tags[i]], casted)
+		if err != nil {
+			return err
+		}
+		vlans[i] = vlan
+		return nil
+	})
+	if errs != nil {
+		return summarizeVlanSetErrors("read", tags, errs)
+	}
+
+	current := make(map[string]*structs.Vlan)
+	for i, vlan := range vlans {
+		if vlan.InUse {
+			current[tags[i]] = vlan
+		} else {
+			log.Printf("! Vlan %s with tag %s is no longer in use", vlan.Id, tags[i])
+		}
+	}
+
+	if len(current) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if len(current) != len(ids) {
+		err := d.Set("size", len(current))
+		if err != nil {
+			return err
+		}
+	}
+
+	return setVlanSetState(d, current)
+}
+
+// Acquire the VLANs for tags that are now in the set and release those that no longer are
+// State is updated with whatever succeeded, even on error
+func casterVlanSetUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	prefix := d.Get("tag_prefix").(string)
+	size := d.Get("size").(int)
+	ids := vlanSetIDs(d)
+
+	toAcquire := []string{}
+	for i := 0; i < size; i++ {
+		tag := vlanSetTag(prefix, i)
+		if _, ok := ids[tag]; !ok {
+			toAcquire = append(toAcquire, tag)
+		}
+	}
+
+	toRelease := make(map[string]string)
+	for tag, id := range ids {
+		index, err := strconv.Atoi(strings.TrimPrefix(tag, prefix))
+		if err != nil || index >= size {
+			toRelease[tag] = id
+		}
+	}
+
+	log.Printf("! Updating vlan set %s, acquiring %d vlans and releasing %d", d.Id(), len(toAcquire), len(toRelease))
+
+	current := make(map[string]*structs.Vlan)
+	for tag, id := range ids {
+		current[tag] = &structs.Vlan{Id: id}
+	}
+
+	acquired, acquireErr := acquireVlanSet(d, toAcquire, casted)
+	for tag, vlan := range acquired {
+		current[tag] = vlan
+	}
+
+	released, releaseErr := releaseVlanSet(d, toRelease, d.Timeout(schema.TimeoutUpdate), casted)
+	for _, tag := range released {
+		delete(current, tag)
+	}
+
+	// Only the IDs are known for VLANs that were already in the set, read will fill in the rest
+	err := setVlanSetState(d, current)
+	if err != nil {
+		return err
+	}
+
+	if acquireErr != nil {
+		return acquireErr
+	}
+	if releaseErr != nil {
+		return releaseErr
+	}
+
+	return casterVlanSetRead(d, m)
+}
+
+// Release every VLAN in the set
+// If any can't be released, keep them in state and return an error
+func casterVlanSetDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	ids := vlanSetIDs(d)
+
+	released, err := releaseVlanSet(d, ids, d.Timeout(schema.TimeoutDelete), casted)
+	if err != nil {
+		for _, tag := range released {
+			delete(ids, tag)
+		}
+		setErr := d.Set("ids", ids)
+		if setErr != nil {
+			return setErr
+		}
+		return err
+	}
+
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Returns the tag of the VLAN at the given index of a set
+func vlanSetTag(prefix string, index int) string {
+	return prefix + strconv.Itoa(index)
+}
+
+// Returns the map of tags to Caster VLAN IDs from state
+func vlanSetIDs(d *schema.ResourceData) map[string]string {
+	ids := make(map[string]string)
+	for tag, id := range d.Get("ids").(map[string]interface{}) {
+		ids[tag] = id.(string)
+	}
+	return ids
+}
+
+// Acquires one VLAN for each tag, at most concurrency at a time. If tag_prefix is set, each VLAN is also tagged
+// in Caster.
+//
+// Returns the VLANs that were acquired, even if an error occurred, and an error describing any failures
+func acquireVlanSet(d *schema.ResourceData, tags []string, m map[string]string) (map[string]*structs.Vlan, error) {
+	prefix := d.Get("tag_prefix").(string)
+	command := structs.VlanCreateCommand{
+		ProjectId:   d.Get("project_id").(string),
+		PartitionId: d.Get("partition_id").(string),
+	}
+
+	vlans := make([]*structs.Vlan, len(tags))
+	errs := util.ForEachConcurrently(len(tags), d.Get("concurrency").(int), func(i int) error {
+		current := command
+		if prefix != "" {
+			current.Tag = tags[i]
+		}
+
+		vlan, err := api.CreateVlan(&current, m)
+		if err != nil {
+			return err
+		}
+		vlans[i] = vlan
+		return nil
+	})
+
+	acquired := make(map[string]*structs.Vlan)
+	for i, vlan := range vlans {
+		if vlan != nil {
+			acquired[tags[i]] = vlan
+		}
+	}
+
+	if errs != nil {
+		return acquired, summarizeVlanSetErrors("acquire", tags, errs)
+	}
+	return acquired, nil
+}
+
+// Releases the given VLANs, at most concurrency at a time. VLANs locked by a Caster run are retried until timeout.
+// A VLAN that fails to release but is no longer in use is treated as released, so destroying a set can be retried.
+//
+// Returns the tags of the VLANs that were released, even if an error occurred, and an error describing any failures
+func releaseVlanSet(d *schema.ResourceData, ids map[string]string, timeout time.Duration, m map[string]string) ([]string, error) {
+	tags := make([]string, 0, len(ids))
+	for tag := range ids {
+		tags = append(tags, tag)
+	}
+
+	errs := util.ForEachConcurrently(len(tags), d.Get("concurrency").(int), func(i int) error {
+		err := retryWhileCasterLocked(timeout, "vlan "+ids[tags[i]], "", func() error {
+			return api.DeleteVlan(ids[tags[i]], m)
+		})
+		if err == nil {
+			return nil
+		}
+
+		vlan, readErr := api.ReadVlan(ids[tags[i]], m)
+		if readErr == nil && !vlan.InUse {
+			return nil
+		}
+		return err
+	})
+
+	released := []string{}
+	for i, tag := range tags {
+		if errs == nil || errs[i] == nil {
+			released = append(released, tag)
+		}
+	}
+
+	if errs != nil {
+		return released, summarizeVlanSetErrors("release", tags, errs)
+	}
+	return released, nil
+}
+
+// Sets the vlans and ids maps in state from the VLANs in the set
+func setVlanSetState(d *schema.ResourceData, vlans map[string]*structs.Vlan) error {
+	vlanIDs := make(map[string]interface{})
+	ids := make(map[string]interface{})
+	partition := ""
+
+	for tag, vlan := range vlans {
+		ids[tag] = vlan.Id
+		if vlan.PartitionId != "" {
+			vlanIDs[tag] = vlan.VlanId
+			partition = vlan.PartitionId
+		}
+	}
+
+	err := d.Set("ids", ids)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("vlans", vlanIDs)
+	if err != nil {
+		return err
+	}
+
+	if partition != "" {
+		return d.Set("partition_id", partition)
+	}
+	return nil
+}
+
+// Combines the errors from acquiring, reading or releasing VLANs in a set into a single error
+func summarizeVlanSetErrors(action string, tags []string, errs []error) error {
+	messages := []string{}
+	for i, err := range errs {
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("%s: %v", tags[i], err))
+		}
+	}
+	sort.Strings(messages)
+
+	return fmt.Errorf("failed to %s %d of %d vlans: %s", action, len(messages), len(tags), strings.Join(messages, "; "))
+}
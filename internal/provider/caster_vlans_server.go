@@ -0,0 +1,181 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// Lists the VLANs of a partition, optionally filtered. A VLAN is available if it is neither in use nor reserved.
+func casterVlansDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: casterVlansDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"partition_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"partition_id", "project_id"},
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"in_use": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"reserved": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_available": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"available_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vlan_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"vlans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"in_use": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"reserved": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read the VLANs from the API and apply the filters
+// Return an error if fewer than min_available VLANs are available
+func casterVlansDataSourceRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	partitionID := d.Get("partition_id").(string)
+	projectID := d.Get("project_id").(string)
+	casted := m.(map[string]string)
+
+	vlans, err := api.ListVlans(partitionID, projectID, casted)
+	if err != nil {
+		return err
+	}
+
+	available := 0
+	for _, vlan := range vlans {
+		if !vlan.InUse && !vlan.Reserved {
+			available++
+		}
+	}
+
+	if min, ok := d.GetOk("min_available"); ok && available < min.(int) {
+		source := "partition " + partitionID
+		if projectID != "" {
+			source = "the partition of project " + projectID
+		}
+		return fmt.Errorf("%s has %d available vlans of %d, but at least %d are required", source, available, len(vlans), min.(int))
+	}
+
+	filtered := []structs.Vlan{}
+	for _, vlan := range vlans {
+		if vlanMatchesFilters(d, vlan) {
+			filtered = append(filtered, vlan)
+		}
+	}
+
+	log.Printf("! Found %d vlans, %d match the filters and %d are available", len(vlans), len(filtered), available)
+
+	if projectID != "" {
+		d.SetId(projectID)
+	} else {
+		d.SetId(partitionID)
+	}
+
+	err = d.Set("available_count", available)
+	if err != nil {
+		return err
+	}
+
+	vlanIDs := []interface{}{}
+	vlanList := []interface{}{}
+	for _, vlan := range filtered {
+		vlanIDs = append(vlanIDs, vlan.VlanId)
+		vlanList = append(vlanList, map[string]interface{}{
+			"id":       vlan.Id,
+			"vlan_id":  vlan.VlanId,
+			"in_use":   vlan.InUse,
+			"reserved": vlan.Reserved,
+			"tag":      vlan.Tag,
+		})
+	}
+
+	err = d.Set("vlan_ids", vlanIDs)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("vlans", vlanList)
+}
+
+// -------------------- Helper functions --------------------
+
+// Returns whether a VLAN matches the in_use, reserved and tag filters that are set
+func vlanMatchesFilters(d *schema.ResourceData, vlan structs.Vlan) bool {
+	// GetOkExists is needed to tell a filter set to false apart from one that isn't set
+	if inUse, ok := d.GetOkExists("in_use"); ok && inUse.(bool) != vlan.InUse {
+		return false
+	}
+
+	if reserved, ok := d.GetOkExists("reserved"); ok && reserved.(bool) != vlan.Reserved {
+		return false
+	}
+
+	if tag, ok := d.GetOk("tag"); ok && tag.(string) != vlan.Tag {
+		return false
+	}
+
+	return true
+}
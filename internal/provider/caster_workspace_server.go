@@ -0,0 +1,367 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The name of the file in a workspace that holds the variables set on the workspace resource
+const workspaceVariablesFile = "crucible.auto.tfvars.json"
+
+// Caster has no separate API for variables, so the variables of a workspace are kept in a tfvars file that belongs
+// to the workspace. While a run is in progress the workspace is locked, and updates and deletes wait for the lock to
+// be released unless force_unlock is set.
+func casterWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: casterWorkspaceCreate,
+		Read:   casterWorkspaceRead,
+		Update: casterWorkspaceUpdate,
+		Delete: casterWorkspaceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dynamic_host": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"force_unlock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"variables_file_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Get workspace properties from d
+// Call API to create workspace, then the file holding its variables
+// Call read to make sure everything worked
+func casterWorkspaceCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	workspace := &structs.CasterWorkspace{
+		DirectoryId: d.Get("directory_id").(string),
+		Name:        d.Get("name").(string),
+		DynamicHost: d.Get("dynamic_host").(bool),
+	}
+
+	casted := m.(map[string]string)
+	created, err := api.CreateCasterWorkspace(workspace, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	err = applyWorkspaceVariables(d, casted)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! Caster workspace created with ID %s", d.Id())
+	return casterWorkspaceRead(d, m)
+}
+
+// Check if workspace exists. If not, set id to "" and return nil
+// Read workspace info and its variables from API
+// Use it to update local state
+func casterWorkspaceRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterWorkspaceExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	workspace, err := api.ReadCasterWorkspace(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("directory_id", workspace.DirectoryId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", workspace.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("dynamic_host", workspace.DynamicHost)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("locked", workspace.IsLocked)
+	if err != nil {
+		return err
+	}
+
+	variables := make(map[string]interface{})
+	fileID := d.Get("variables_file_id").(string)
+
+	if fileID != "" {
+		exists, err = api.CasterFileExists(fileID, casted)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			file, err := api.ReadCasterFile(fileID, casted)
+			if err != nil {
+				return err
+			}
+
+			variables, err = tfvarsFromContent(file.Content)
+			if err != nil {
+				return err
+			}
+		} else {
+			fileID = ""
+		}
+	}
+
+	err = d.Set("variables_file_id", fileID)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("variables", variables)
+}
+
+// Call API to update the workspace and its variables, waiting while the workspace is locked
+// Call read to make sure everything worked
+func casterWorkspaceUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+	if d.HasChanges("name", "dynamic_host") {
+		workspace := &structs.CasterWorkspace{
+			Id:          d.Id(),
+			DirectoryId: d.Get("directory_id").(string),
+			Name:        d.Get("name").(string),
+			DynamicHost: d.Get("dynamic_host").(bool),
+		}
+
+		err := retryWhileCasterLocked(time.Until(deadline), "workspace "+d.Id(), casterForceUnlockHint,
+			withForceUnlock(d, casted, func() error {
+				return api.UpdateCasterWorkspace(workspace, casted)
+			}))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("variables") {
+		err := retryWhileCasterLocked(time.Until(deadline), "workspace "+d.Id(), casterForceUnlockHint,
+			withForceUnlock(d, casted, func() error {
+				return applyWorkspaceVariables(d, casted)
+			}))
+		if err != nil {
+			return err
+		}
+	}
+
+	return casterWorkspaceRead(d, m)
+}
+
+// Check if workspace exists
+// Call API to delete it, waiting while the workspace is locked. Caster deletes the workspace's files with it.
+func casterWorkspaceDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterWorkspaceExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return retryWhileCasterLocked(d.Timeout(schema.TimeoutDelete), "workspace "+id, casterForceUnlockHint,
+		withForceUnlock(d, casted, func() error {
+			return api.DeleteCasterWorkspace(id, casted)
+		}))
+}
+
+// -------------------- Helper functions --------------------
+
+// Added to the error when a workspace stays locked, for resources that support force_unlock
+const casterForceUnlockHint = ", or set force_unlock to release the lock"
+
+// Calls fn until it succeeds or fails for a reason other than a Caster lock. While fn fails because of a lock, it is
+// retried until timeout. If the lock is never released, the returned error says what is locked and how to proceed.
+func retryWhileCasterLocked(timeout time.Duration, description, hint string, fn func() error) error {
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := fn()
+		if errors.Is(err, api.ErrCasterLocked) {
+			log.Printf("! %s is locked by a Caster run, waiting for it to be released", description)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	// Retry returns the last error if it times out
+	if errors.Is(err, api.ErrCasterLocked) {
+		return fmt.Errorf("%s is still locked by a Caster run in progress after waiting %s. Wait for the run to finish and try again%s",
+			description, timeout, hint)
+	}
+	return err
+}
+
+// Wraps fn so that if it fails because the workspace is locked and force_unlock is set, the lock is released and fn
+// is called again
+func withForceUnlock(d *schema.ResourceData, m map[string]string, fn func() error) func() error {
+	return func() error {
+		err := fn()
+		if !errors.Is(err, api.ErrCasterLocked) || !d.Get("force_unlock").(bool) {
+			return err
+		}
+
+		log.Printf("! Workspace %s is locked, forcing it to unlock", d.Id())
+		err = api.UnlockCasterWorkspace(d.Id(), m)
+		if err != nil {
+			return err
+		}
+		return fn()
+	}
+}
+
+// Creates, updates or deletes the file holding a workspace's variables to match the variables in config
+func applyWorkspaceVariables(d *schema.ResourceData, m map[string]string) error {
+	variables := d.Get("variables").(map[string]interface{})
+	fileID := d.Get("variables_file_id").(string)
+
+	if len(variables) == 0 {
+		if fileID == "" {
+			return nil
+		}
+
+		err := api.DeleteCasterFile(fileID, m)
+		if err != nil {
+			return err
+		}
+		return d.Set("variables_file_id", "")
+	}
+
+	content, err := tfvarsContent(variables)
+	if err != nil {
+		return err
+	}
+
+	file := &structs.CasterFile{
+		Id:          fileID,
+		DirectoryId: d.Get("directory_id").(string),
+		WorkspaceId: d.Id(),
+		Name:        workspaceVariablesFile,
+		Content:     content,
+	}
+
+	if fileID != "" {
+		return api.UpdateCasterFile(file, m)
+	}
+
+	created, err := api.CreateCasterFile(file, m)
+	if err != nil {
+		return err
+	}
+	return d.Set("variables_file_id", created.Id)
+}
+
+// Returns the content of a tfvars JSON file setting the given variables
+func tfvarsContent(variables map[string]interface{}) (string, error) {
+	asJSON, err := json.MarshalIndent(variables, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(asJSON) + "\n", nil
+}
+
+// Returns the variables set by a tfvars JSON file. Values that aren't strings are returned as JSON.
+func tfvarsFromContent(content string) (map[string]interface{}, error) {
+	asMap := make(map[string]interface{})
+	err := json.Unmarshal([]byte(content), &asMap)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing tfvars file: %v", err)
+	}
+
+	variables := make(map[string]interface{})
+	for name, value := range asMap {
+		if asStr, ok := value.(string); ok {
+			variables[name] = asStr
+			continue
+		}
+
+		asJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		variables[name] = string(asJSON)
+	}
+	return variables, nil
+}
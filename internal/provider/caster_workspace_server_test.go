@@ -0,0 +1,272 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of a workspace and its variables against a stub of the Caster API
+//
+// Execution steps
+// 1. Terraform creates a workspace with variables and a separate workspace variable
+// 2. Verify local state and the files in the stub
+// 3. Terraform updates the workspace, its variables and the workspace variable
+// 4. Verify state
+// 5. Terraform removes the workspace's variables
+// 6. Verify the variables file was deleted
+// 7. Terraform destroys resources
+//
+// Expected behavior:
+// Resources are created, updated, and destroyed without error, and variables are kept in tfvars files
+func TestCasterWorkspace(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("workspaces") != 0 || stub.count("files") != 0 {
+				return fmt.Errorf("expected no workspaces or files after destroy, found %d workspaces and %d files",
+					stub.count("workspaces"), stub.count("files"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configCasterWorkspace("team-1", `{ team = "1", vlan = "100" }`, "Red"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "name", "team-1"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "dynamic_host", "true"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "variables.vlan", "100"),
+					resource.TestCheckResourceAttrSet("crucible_caster_workspace.test", "variables_file_id"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace_variable.test", "value", "Red"),
+					verifyStubTfvars(stub, "crucible.auto.tfvars.json", map[string]string{"team": "1", "vlan": "100"}),
+					verifyStubTfvars(stub, "team_name.auto.tfvars.json", map[string]string{"team_name": "Red"}),
+				),
+			},
+			{
+				Config: stub.providerConfig() + configCasterWorkspace("team-1-updated", `{ team = "2" }`, "Blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "name", "team-1-updated"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "variables.%", "1"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "variables.team", "2"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace_variable.test", "value", "Blue"),
+					verifyStubTfvars(stub, "crucible.auto.tfvars.json", map[string]string{"team": "2"}),
+					verifyStubTfvars(stub, "team_name.auto.tfvars.json", map[string]string{"team_name": "Blue"}),
+				),
+			},
+			{
+				Config: stub.providerConfig() + configCasterWorkspace("team-1-updated", "{}", "Blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "variables.%", "0"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "variables_file_id", ""),
+					func(s *terraform.State) error {
+						if files := stub.find("files", "name", "crucible.auto.tfvars.json"); len(files) != 0 {
+							return fmt.Errorf("expected the variables file to be deleted")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// Test case for changing a workspace while a simulated Caster run holds its lock
+//
+// Execution steps
+// 1. Terraform creates a workspace, then the stub locks it
+// 2. Terraform renames the workspace with a short update timeout
+// 3. Verify the update fails with a message saying the workspace is locked
+// 4. Terraform renames the workspace again while the stub releases the lock after a second
+// 5. Verify the update waited for the lock and succeeded
+// 6. Terraform destroys the workspace
+//
+// Expected behavior:
+// Updates wait for the lock, and fail with a clear error if it isn't released in time
+func TestCasterWorkspaceLocked(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("workspaces") != 0 {
+				return fmt.Errorf("expected no workspaces after destroy, found %d", stub.count("workspaces"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configCasterLockedWorkspace("team-1", false, "2s"),
+				Check: func(s *terraform.State) error {
+					id = s.RootModule().Resources["crucible_caster_workspace.test"].Primary.ID
+					stub.setLocked(id, true)
+					return nil
+				},
+			},
+			{
+				Config:      stub.providerConfig() + configCasterLockedWorkspace("team-1-updated", false, "2s"),
+				ExpectError: regexp.MustCompile("is still locked by a Caster run in progress"),
+			},
+			{
+				PreConfig: func() {
+					go func() {
+						time.Sleep(time.Second)
+						stub.setLocked(id, false)
+					}()
+				},
+				Config: stub.providerConfig() + configCasterLockedWorkspace("team-1-updated", false, "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "name", "team-1-updated"),
+					resource.TestCheckResourceAttr("crucible_caster_workspace.test", "locked", "false"),
+				),
+			},
+		},
+	})
+}
+
+// Test case for destroying a locked workspace with force_unlock set
+//
+// Execution steps
+// 1. Terraform creates a workspace with force_unlock, then the stub locks it
+// 2. Terraform destroys the workspace
+// 3. Verify the workspace was unlocked through the API and deleted
+//
+// Expected behavior:
+// The workspace is unlocked and destroyed without waiting
+func TestCasterWorkspaceForceUnlock(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("workspaces") != 0 {
+				return fmt.Errorf("expected no workspaces after destroy, found %d", stub.count("workspaces"))
+			}
+			if stub.unlockCount() != 1 {
+				return fmt.Errorf("expected the workspace to be force unlocked once, was unlocked %d times", stub.unlockCount())
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configCasterLockedWorkspace("team-1", true, "1s"),
+				Check: func(s *terraform.State) error {
+					stub.setLocked(s.RootModule().Resources["crucible_caster_workspace.test"].Primary.ID, true)
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// Test case for a workspace variable named crucible
+//
+// Execution steps
+// 1. Terraform plans a workspace variable named crucible
+// 2. Verify the plan fails because the name is reserved
+//
+// Expected behavior:
+// The variable can't overwrite the file that holds the variables attribute of the workspace
+func TestCasterWorkspaceVariableReservedName(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + `
+				resource "crucible_caster_workspace_variable" "test" {
+					workspace_id = "0f1e2d3c-4b5a-4968-8776-655443322110"
+					name         = "crucible"
+					value        = "Red"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is reserved"),
+			},
+		},
+	})
+}
+
+func configCasterWorkspace(name, variables, teamName string) string {
+	return fmt.Sprintf(`
+	resource "crucible_caster_workspace" "test" {
+		directory_id = "5b9e7c1c-6bd0-4e8a-9d0e-2d7a1c3f4e21"
+		name         = "%s"
+		dynamic_host = true
+		variables    = %s
+	}
+
+	resource "crucible_caster_workspace_variable" "test" {
+		workspace_id = crucible_caster_workspace.test.id
+		name         = "team_name"
+		value        = "%s"
+	}
+	`, name, variables, teamName)
+}
+
+func configCasterLockedWorkspace(name string, forceUnlock bool, timeout string) string {
+	return fmt.Sprintf(`
+	resource "crucible_caster_workspace" "test" {
+		directory_id = "5b9e7c1c-6bd0-4e8a-9d0e-2d7a1c3f4e21"
+		name         = "%s"
+		force_unlock = %t
+
+		timeouts {
+			update = "%s"
+			delete = "%s"
+		}
+	}
+	`, name, forceUnlock, timeout, timeout)
+}
+
+// Verifies that the stub has exactly one file with the given name, belonging to a workspace, that sets the
+// expected variables
+func verifyStubTfvars(stub *apiStub, name string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		files := stub.find("files", "name", name)
+		if len(files) != 1 {
+			return fmt.Errorf("expected 1 file named %s, found %d", name, len(files))
+		}
+
+		if files[0]["workspaceId"] == nil {
+			return fmt.Errorf("file %s does not belong to a workspace", name)
+		}
+
+		actual := make(map[string]string)
+		err := json.Unmarshal([]byte(files[0]["content"].(string)), &actual)
+		if err != nil {
+			return err
+		}
+
+		if fmt.Sprintf("%v", actual) != fmt.Sprintf("%v", expected) {
+			return fmt.Errorf("file %s sets %v, expected %v", name, actual, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// Each variable is kept in its own tfvars file in the workspace, so the ID of this resource is the ID of that file
+func casterWorkspaceVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: casterWorkspaceVariableCreate,
+		Read:   casterWorkspaceVariableRead,
+		Update: casterWorkspaceVariableUpdate,
+		Delete: casterWorkspaceVariableDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`),
+						"must be a valid Terraform variable name"),
+					// Its file would be the one that holds the variables attribute of the workspace
+					validation.StringDoesNotMatch(regexp.MustCompile(`^crucible$`),
+						"is reserved, as "+workspaceVariablesFile+" holds the variables of crucible_caster_workspace"),
+				),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// Call API to create a file in the workspace setting the variable
+// Call read to make sure everything worked
+func casterWorkspaceVariableCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	workspaceID := d.Get("workspace_id").(string)
+	casted := m.(map[string]string)
+
+	// Workspace files must also belong to the workspace's directory
+	workspace, err := api.ReadCasterWorkspace(workspaceID, casted)
+	if err != nil {
+		return err
+	}
+
+	content, err := workspaceVariableContent(d)
+	if err != nil {
+		return err
+	}
+
+	file := &structs.CasterFile{
+		DirectoryId: workspace.DirectoryId,
+		WorkspaceId: workspaceID,
+		Name:        workspaceVariableFile(d.Get("name").(string)),
+		Content:     content,
+	}
+
+	created, err := api.CreateCasterFile(file, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Caster workspace variable created in file %s", d.Id())
+	return casterWorkspaceVariableRead(d, m)
+}
+
+// Check if the variable's file exists. If not, set id to "" and return nil
+// Read the variable from the file
+// Use it to update local state
+func casterWorkspaceVariableRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterFileExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	file, err := api.ReadCasterFile(id, casted)
+	if err != nil {
+		return err
+	}
+
+	variables, err := tfvarsFromContent(file.Content)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("workspace_id", file.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	value, _ := variables[d.Get("name").(string)].(string)
+	return d.Set("value", value)
+}
+
+// Call API to update the variable's file, waiting while the workspace is locked
+// Call read to make sure everything worked
+func casterWorkspaceVariableUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+
+	file, err := api.ReadCasterFile(d.Id(), casted)
+	if err != nil {
+		return err
+	}
+
+	file.Content, err = workspaceVariableContent(d)
+	if err != nil {
+		return err
+	}
+
+	err = retryWhileCasterLocked(d.Timeout(schema.TimeoutUpdate), "workspace "+file.WorkspaceId, "", func() error {
+		return api.UpdateCasterFile(file, casted)
+	})
+	if err != nil {
+		return err
+	}
+
+	return casterWorkspaceVariableRead(d, m)
+}
+
+// Check if the variable's file exists
+// Call API to delete it, waiting while the workspace is locked
+func casterWorkspaceVariableDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.CasterFileExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return retryWhileCasterLocked(d.Timeout(schema.TimeoutDelete), "workspace "+d.Get("workspace_id").(string), "", func() error {
+		return api.DeleteCasterFile(id, casted)
+	})
+}
+
+// -------------------- Helper functions --------------------
+
+// Returns the name of the file in a workspace that holds a variable
+func workspaceVariableFile(name string) string {
+	return name + ".auto.tfvars.json"
+}
+
+// Returns the content of the tfvars file for the variable in config
+func workspaceVariableContent(d *schema.ResourceData) (string, error) {
+	return tfvarsContent(map[string]interface{}{
+		d.Get("name").(string): d.Get("value").(string),
+	})
+}
@@ -0,0 +1,475 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A CITE evaluation with its moves and teams. Moves are matched to the ones in CITE by move number and teams by name.
+func citeEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Create: citeEvaluationCreate,
+		Read:   citeEvaluationRead,
+		Update: citeEvaluationUpdate,
+		Delete: citeEvaluationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scoring_model_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Pending",
+				ValidateFunc: validation.StringInSlice(citeStatuses, false),
+			},
+			"current_move_number": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"situation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"situation_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// A list rather than a set, as situation_time is computed and would change the hash of a move
+			"move": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"move_number": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"situation_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: util.SuppressEquivalentTimes,
+						},
+						"situation_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"team": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"short_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"team_type_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get evaluation properties from d
+// Call API to create evaluation, then its moves and teams
+// Call read to make sure everything worked
+func citeEvaluationCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateCiteEvaluation(citeEvaluationFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	err = syncCiteMoves(d, casted)
+	if err != nil {
+		return err
+	}
+
+	err = syncCiteTeams(d, casted)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! CITE evaluation created with ID %s", d.Id())
+	return citeEvaluationRead(d, m)
+}
+
+// Check if evaluation exists. If not, set id to "" and return nil
+// Read evaluation info, its moves and teams from API
+// Use it to update local state
+func citeEvaluationRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.CiteEvaluationExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	evaluation, err := api.ReadCiteEvaluation(id, casted)
+	if err != nil {
+		return err
+	}
+
+	moves, err := api.ListCiteMoves(id, casted)
+	if err != nil {
+		return err
+	}
+
+	teams, err := api.ListCiteTeams(id, casted)
+	if err != nil {
+		return err
+	}
+
+	// Keep moves in the order they are in config, so listing them out of order doesn't cause a diff. Moves that
+	// aren't in config, such as after an import, go at the end in order of move number.
+	position := make(map[int]int)
+	for i, raw := range d.Get("move").([]interface{}) {
+		position[raw.(map[string]interface{})["move_number"].(int)] = i
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		iPos, iFound := position[moves[i].MoveNumber]
+		jPos, jFound := position[moves[j].MoveNumber]
+		if iFound != jFound {
+			return iFound
+		}
+		if iFound {
+			return iPos < jPos
+		}
+		return moves[i].MoveNumber < moves[j].MoveNumber
+	})
+
+	localMoves := make([]map[string]interface{}, 0, len(moves))
+	for _, move := range moves {
+		localMoves = append(localMoves, map[string]interface{}{
+			"id":                    move.Id,
+			"move_number":           move.MoveNumber,
+			"description":           move.Description,
+			"situation_time":        move.SituationTime,
+			"situation_description": move.SituationDescription,
+		})
+	}
+
+	localTeams := make([]map[string]interface{}, 0, len(teams))
+	for _, team := range teams {
+		localTeams = append(localTeams, map[string]interface{}{
+			"id":           team.Id,
+			"name":         team.Name,
+			"short_name":   team.ShortName,
+			"team_type_id": team.TeamTypeId,
+		})
+	}
+
+	err = d.Set("description", evaluation.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scoring_model_id", evaluation.ScoringModelId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("view_id", evaluation.ViewId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", evaluation.Status)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("current_move_number", evaluation.CurrentMoveNumber)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("situation_time", evaluation.SituationTime)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("situation_description", evaluation.SituationDescription)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("move", localMoves)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("team", localTeams)
+}
+
+// Get evaluation properties from d
+// Call API to update evaluation, then create, update or delete its moves and teams to match config
+// Call read to make sure everything worked
+func citeEvaluationUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("description", "view_id", "status", "current_move_number", "situation_time", "situation_description") {
+		evaluation := citeEvaluationFromConfig(d)
+		evaluation.Id = d.Id()
+
+		err := api.UpdateCiteEvaluation(evaluation, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("move") {
+		err := syncCiteMoves(d, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("team") {
+		err := syncCiteTeams(d, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return citeEvaluationRead(d, m)
+}
+
+// Check if evaluation exists
+// Call API to delete it. CITE deletes the evaluation's moves and teams with it.
+func citeEvaluationDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.CiteEvaluationExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCiteEvaluation(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds an evaluation struct from the resource's config
+func citeEvaluationFromConfig(d *schema.ResourceData) *structs.CiteEvaluation {
+	return &structs.CiteEvaluation{
+		Description:          d.Get("description").(string),
+		ScoringModelId:       d.Get("scoring_model_id").(string),
+		ViewId:               d.Get("view_id").(string),
+		Status:               d.Get("status").(string),
+		CurrentMoveNumber:    d.Get("current_move_number").(int),
+		SituationTime:        d.Get("situation_time").(string),
+		SituationDescription: d.Get("situation_description").(string),
+	}
+}
+
+// Creates, updates and deletes the moves of the evaluation to match config. Moves are matched by move number.
+func syncCiteMoves(d *schema.ResourceData, m map[string]string) error {
+	existing, err := api.ListCiteMoves(d.Id(), m)
+	if err != nil {
+		return err
+	}
+
+	byNumber := make(map[int]structs.CiteMove)
+	for _, move := range existing {
+		byNumber[move.MoveNumber] = move
+	}
+
+	wanted := make(map[int]bool)
+	for _, raw := range d.Get("move").([]interface{}) {
+		asMap := raw.(map[string]interface{})
+		move := structs.CiteMove{
+			EvaluationId:         d.Id(),
+			MoveNumber:           asMap["move_number"].(int),
+			Description:          asMap["description"].(string),
+			SituationTime:        asMap["situation_time"].(string),
+			SituationDescription: asMap["situation_description"].(string),
+		}
+
+		if wanted[move.MoveNumber] {
+			return fmt.Errorf("evaluation has more than one move numbered %d", move.MoveNumber)
+		}
+		wanted[move.MoveNumber] = true
+
+		if old, ok := byNumber[move.MoveNumber]; ok {
+			move.Id = old.Id
+			// CITE may return the time in a different format than it was given in
+			if util.EquivalentTimes(old.SituationTime, move.SituationTime) {
+				move.SituationTime = old.SituationTime
+			}
+			if old != move {
+				err = api.UpdateCiteMove(&move, m)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		_, err = api.CreateCiteMove(&move, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, move := range existing {
+		if !wanted[move.MoveNumber] {
+			err = api.DeleteCiteMove(move.Id, m)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Creates, updates and deletes the teams of the evaluation to match config. Teams are matched by name.
+func syncCiteTeams(d *schema.ResourceData, m map[string]string) error {
+	existing, err := api.ListCiteTeams(d.Id(), m)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]structs.CiteTeam)
+	for _, team := range existing {
+		byName[team.Name] = team
+	}
+
+	wanted := make(map[string]bool)
+	for _, raw := range d.Get("team").(*schema.Set).List() {
+		asMap := raw.(map[string]interface{})
+		team := structs.CiteTeam{
+			EvaluationId: d.Id(),
+			Name:         asMap["name"].(string),
+			ShortName:    asMap["short_name"].(string),
+			TeamTypeId:   asMap["team_type_id"].(string),
+		}
+
+		if wanted[team.Name] {
+			return fmt.Errorf("evaluation has more than one team named %q", team.Name)
+		}
+		wanted[team.Name] = true
+
+		if old, ok := byName[team.Name]; ok {
+			team.Id = old.Id
+			if old != team {
+				err = api.UpdateCiteTeam(&team, m)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		_, err = api.CreateCiteTeam(&team, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, team := range existing {
+		if !wanted[team.Name] {
+			err = api.DeleteCiteTeam(team.Id, m)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
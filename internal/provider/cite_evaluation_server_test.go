@@ -0,0 +1,107 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of an evaluation's moves and teams against a stub of the CITE API
+//
+// Execution steps
+// 1. Terraform creates an evaluation with two moves and two teams, listed out of order
+// 2. Verify local state and the moves and teams in the stub
+// 3. Terraform changes the description of a move, removes a team and adds another
+// 4. Verify the move was updated in place and the teams were deleted and created
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// The order of the blocks doesn't cause a diff, and moves and teams are matched by move number and name
+func TestCiteEvaluation(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var moveID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("evaluations") != 0 {
+				return fmt.Errorf("expected no evaluations after destroy, found %d", stub.count("evaluations"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configCiteEvaluation("Initial report", "Red"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_cite_evaluation.test", "description", "Exercise"),
+					resource.TestCheckResourceAttr("crucible_cite_evaluation.test", "move.#", "2"),
+					resource.TestCheckResourceAttr("crucible_cite_evaluation.test", "team.#", "2"),
+					func(s *terraform.State) error {
+						moves := stub.find("moves", "moveNumber", "0")
+						if len(moves) != 1 || stub.count("moves") != 2 || stub.count("teams") != 2 {
+							return fmt.Errorf("expected 2 moves and 2 teams in the stub, found %d and %d",
+								stub.count("moves"), stub.count("teams"))
+						}
+						moveID = moves[0]["id"].(string)
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configCiteEvaluation("Updated report", "Blue"),
+				Check: func(s *terraform.State) error {
+					moves := stub.find("moves", "moveNumber", "0")
+					if len(moves) != 1 || moves[0]["id"] != moveID || moves[0]["description"] != "Updated report" {
+						return fmt.Errorf("expected move 0 to be updated in place, found %v", moves)
+					}
+					if len(stub.find("teams", "name", "Red")) != 0 || len(stub.find("teams", "name", "Blue")) != 1 {
+						return fmt.Errorf("expected team Red to be replaced by team Blue")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func configCiteEvaluation(moveDescription, team string) string {
+	return fmt.Sprintf(`
+	resource "crucible_cite_evaluation" "test" {
+		description      = "Exercise"
+		scoring_model_id = "6f2b0a6e-0c1a-4d2e-9a0b-8e1f5c7d3a10"
+		situation_time   = "2022-03-01T12:00:00Z"
+
+		move {
+			move_number    = 1
+			description    = "Escalation"
+			situation_time = "2022-03-01T13:00:00Z"
+		}
+
+		move {
+			move_number    = 0
+			description    = "%s"
+			situation_time = "2022-03-01T12:00:00Z"
+		}
+
+		team {
+			name       = "%s"
+			short_name = "%s"
+		}
+
+		team {
+			name       = "Agency"
+			short_name = "AG"
+		}
+	}
+	`, moveDescription, team, team)
+}
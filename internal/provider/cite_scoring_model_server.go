@@ -0,0 +1,421 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The statuses of CITE scoring models and evaluations
+var citeStatuses = []string{"Pending", "Active", "Cancelled", "Complete", "Archived"}
+
+// A CITE scoring model with its categories and their options. Categories and options are kept in the order they are
+// listed in config, and are matched to the ones in CITE by description, so descriptions must be unique.
+func citeScoringModel() *schema.Resource {
+	return &schema.Resource{
+		Create: citeScoringModelCreate,
+		Read:   citeScoringModelRead,
+		Update: citeScoringModelUpdate,
+		Delete: citeScoringModelDelete,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Active",
+				ValidateFunc: validation.StringInSlice(citeStatuses, false),
+			},
+			"calculation_equation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "{sum}",
+			},
+			"category": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"calculation_equation": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "{sum}",
+						},
+						"scoring_weight": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  1.0,
+						},
+						"is_modifier_required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"option_selection": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Single",
+							ValidateFunc: validation.StringInSlice([]string{"Single", "Multiple", "None"}, false),
+						},
+						"option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+									"is_modifier": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get scoring model properties from d
+// Call API to create scoring model, then its categories and options
+// Call read to make sure everything worked
+func citeScoringModelCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateCiteScoringModel(citeScoringModelFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	err = syncCiteCategories(d, casted)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! CITE scoring model created with ID %s", d.Id())
+	return citeScoringModelRead(d, m)
+}
+
+// Check if scoring model exists. If not, set id to "" and return nil
+// Read scoring model info, its categories and their options from API
+// Use it to update local state
+func citeScoringModelRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.CiteScoringModelExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	model, err := api.ReadCiteScoringModel(id, casted)
+	if err != nil {
+		return err
+	}
+
+	categories, err := api.ListCiteScoringCategories(id, casted)
+	if err != nil {
+		return err
+	}
+
+	// Sort so the categories and options are in the same order as in config
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].DisplayOrder < categories[j].DisplayOrder
+	})
+
+	localCategories := make([]map[string]interface{}, 0, len(categories))
+	for _, category := range categories {
+		options, err := api.ListCiteScoringOptions(category.Id, casted)
+		if err != nil {
+			return err
+		}
+
+		sort.Slice(options, func(i, j int) bool {
+			return options[i].DisplayOrder < options[j].DisplayOrder
+		})
+
+		localOptions := make([]map[string]interface{}, 0, len(options))
+		for _, option := range options {
+			localOptions = append(localOptions, map[string]interface{}{
+				"id":          option.Id,
+				"description": option.Description,
+				"value":       option.Value,
+				"is_modifier": option.IsModifier,
+			})
+		}
+
+		localCategories = append(localCategories, map[string]interface{}{
+			"id":                   category.Id,
+			"description":          category.Description,
+			"calculation_equation": category.CalculationEquation,
+			"scoring_weight":       category.ScoringWeight,
+			"is_modifier_required": category.IsModifierRequired,
+			"option_selection":     category.ScoringOptionSelection,
+			"option":               localOptions,
+		})
+	}
+
+	err = d.Set("description", model.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", model.Status)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("calculation_equation", model.CalculationEquation)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("category", localCategories)
+}
+
+// Get scoring model properties from d
+// Call API to update scoring model, then create, update or delete its categories and options to match config
+// Call read to make sure everything worked
+func citeScoringModelUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("description", "status", "calculation_equation") {
+		model := citeScoringModelFromConfig(d)
+		model.Id = d.Id()
+
+		err := api.UpdateCiteScoringModel(model, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("category") {
+		err := syncCiteCategories(d, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return citeScoringModelRead(d, m)
+}
+
+// Check if scoring model exists
+// Call API to delete it. CITE deletes the model's categories and options with it.
+func citeScoringModelDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "cite_api_url", "CITE")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.CiteScoringModelExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteCiteScoringModel(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a scoring model struct from the resource's config
+func citeScoringModelFromConfig(d *schema.ResourceData) *structs.CiteScoringModel {
+	return &structs.CiteScoringModel{
+		Description:         d.Get("description").(string),
+		Status:              d.Get("status").(string),
+		CalculationEquation: d.Get("calculation_equation").(string),
+	}
+}
+
+// Creates, updates and deletes the categories of the scoring model to match config. Categories are matched by
+// description and their display order is their position in config.
+func syncCiteCategories(d *schema.ResourceData, m map[string]string) error {
+	existing, err := api.ListCiteScoringCategories(d.Id(), m)
+	if err != nil {
+		return err
+	}
+
+	byDescription := make(map[string]structs.CiteScoringCategory)
+	for _, category := range existing {
+		byDescription[category.Description] = category
+	}
+
+	wanted := make(map[string]bool)
+	for i, raw := range d.Get("category").([]interface{}) {
+		asMap := raw.(map[string]interface{})
+		category := structs.CiteScoringCategory{
+			ScoringModelId:         d.Id(),
+			Description:            asMap["description"].(string),
+			DisplayOrder:           i + 1,
+			CalculationEquation:    asMap["calculation_equation"].(string),
+			ScoringWeight:          asMap["scoring_weight"].(float64),
+			IsModifierRequired:     asMap["is_modifier_required"].(bool),
+			ScoringOptionSelection: asMap["option_selection"].(string),
+		}
+
+		if wanted[category.Description] {
+			return fmt.Errorf("scoring model has more than one category with description %q", category.Description)
+		}
+		wanted[category.Description] = true
+
+		if old, ok := byDescription[category.Description]; ok {
+			category.Id = old.Id
+			if old != category {
+				err = api.UpdateCiteScoringCategory(&category, m)
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			created, err := api.CreateCiteScoringCategory(&category, m)
+			if err != nil {
+				return err
+			}
+			category.Id = created.Id
+		}
+
+		err = syncCiteOptions(category.Id, asMap["option"].([]interface{}), m)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, category := range existing {
+		if !wanted[category.Description] {
+			err = api.DeleteCiteScoringCategory(category.Id, m)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Creates, updates and deletes the options of a category to match config. Options are matched by description and
+// their display order is their position in config.
+func syncCiteOptions(categoryID string, options []interface{}, m map[string]string) error {
+	existing, err := api.ListCiteScoringOptions(categoryID, m)
+	if err != nil {
+		return err
+	}
+
+	byDescription := make(map[string]structs.CiteScoringOption)
+	for _, option := range existing {
+		byDescription[option.Description] = option
+	}
+
+	wanted := make(map[string]bool)
+	for i, raw := range options {
+		asMap := raw.(map[string]interface{})
+		option := structs.CiteScoringOption{
+			ScoringCategoryId: categoryID,
+			Description:       asMap["description"].(string),
+			DisplayOrder:      i + 1,
+			Value:             asMap["value"].(float64),
+			IsModifier:        asMap["is_modifier"].(bool),
+		}
+
+		if wanted[option.Description] {
+			return fmt.Errorf("scoring category has more than one option with description %q", option.Description)
+		}
+		wanted[option.Description] = true
+
+		if old, ok := byDescription[option.Description]; ok {
+			option.Id = old.Id
+			if old != option {
+				err = api.UpdateCiteScoringOption(&option, m)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		_, err = api.CreateCiteScoringOption(&option, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, option := range existing {
+		if !wanted[option.Description] {
+			err = api.DeleteCiteScoringOption(option.Id, m)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of a scoring model's categories and options against a stub of the CITE API
+//
+// Execution steps
+// 1. Terraform creates a scoring model with two categories
+// 2. Verify local state and the categories and options in the stub
+// 3. Terraform removes a category, adds another and changes the options of the first
+// 4. Verify the first category was updated in place and the others were deleted and created
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// Categories and options are matched by description, so only the ones that changed are created or deleted
+func TestCiteScoringModel(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var impactID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("scoringModels") != 0 {
+				return fmt.Errorf("expected no scoring models after destroy, found %d", stub.count("scoringModels"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configCiteScoringModel(`
+					category {
+						description = "Impact"
+						option {
+							description = "Low"
+							value       = 1
+						}
+						option {
+							description = "High"
+							value       = 3
+						}
+					}
+					category {
+						description      = "Spread"
+						option_selection = "Multiple"
+						option {
+							description = "Contained"
+							value       = 0
+						}
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.#", "2"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.0.description", "Impact"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.0.option.#", "2"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.0.option.1.description", "High"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.1.option_selection", "Multiple"),
+					func(s *terraform.State) error {
+						impactID = s.RootModule().Resources["crucible_cite_scoring_model.test"].Primary.Attributes["category.0.id"]
+						if stub.count("scoringCategories") != 2 || stub.count("scoringOptions") != 3 {
+							return fmt.Errorf("expected 2 categories and 3 options in the stub, found %d and %d",
+								stub.count("scoringCategories"), stub.count("scoringOptions"))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configCiteScoringModel(`
+					category {
+						description = "Impact"
+						option {
+							description = "Low"
+							value       = 2
+						}
+						option {
+							description = "Severe"
+							value       = 5
+						}
+					}
+					category {
+						description = "Attribution"
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.#", "2"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.0.option.0.value", "2"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.0.option.1.description", "Severe"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.1.description", "Attribution"),
+					resource.TestCheckResourceAttr("crucible_cite_scoring_model.test", "category.1.option.#", "0"),
+					func(s *terraform.State) error {
+						current := s.RootModule().Resources["crucible_cite_scoring_model.test"].Primary.Attributes["category.0.id"]
+						if current != impactID {
+							return fmt.Errorf("expected category Impact to keep ID %s, got %s", impactID, current)
+						}
+						if len(stub.find("scoringCategories", "description", "Spread")) != 0 {
+							return fmt.Errorf("expected category Spread to be deleted")
+						}
+						if len(stub.find("scoringOptions", "scoringCategoryId", impactID)) != 2 {
+							return fmt.Errorf("expected category Impact to have 2 options in the stub")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configCiteScoringModel(categories string) string {
+	return fmt.Sprintf(`
+	resource "crucible_cite_scoring_model" "test" {
+		description = "Incident severity"
+		%s
+	}
+	`, categories)
+}
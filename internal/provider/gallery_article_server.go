@@ -0,0 +1,280 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// An article in a Gallery collection. Teams that can see the article's card see the article once the exhibit reaches
+// its move and inject.
+func galleryArticle() *schema.Resource {
+	return &schema.Resource{
+		Create: galleryArticleCreate,
+		Read:   galleryArticleRead,
+		Update: galleryArticleUpdate,
+		Delete: galleryArticleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"card_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exhibit_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"move": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"inject": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "News",
+			},
+			"source_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"date_posted": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"open_in_new_tab": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Get article properties from d
+// Call API to create article
+// Call read to make sure everything worked
+func galleryArticleCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateGalleryArticle(galleryArticleFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Gallery article created with ID %s", d.Id())
+	return galleryArticleRead(d, m)
+}
+
+// Check if article exists. If not, set id to "" and return nil
+// Read article info from API
+// Use it to update local state
+func galleryArticleRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryArticleExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	article, err := api.ReadGalleryArticle(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("collection_id", article.CollectionId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("card_id", article.CardId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("exhibit_id", article.ExhibitId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", article.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("summary", article.Summary)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", article.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("move", article.Move)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("inject", article.Inject)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("source_type", article.SourceType)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("source_name", article.SourceName)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("url", article.Url)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("date_posted", article.DatePosted)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("open_in_new_tab", article.OpenInNewTab)
+}
+
+// Get article properties from d
+// Call API to update article
+// Call read to make sure everything worked
+func galleryArticleUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	article := galleryArticleFromConfig(d)
+	article.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateGalleryArticle(article, casted)
+	if err != nil {
+		return err
+	}
+
+	return galleryArticleRead(d, m)
+}
+
+// Check if article exists
+// Call API to delete it
+func galleryArticleDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryArticleExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteGalleryArticle(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds an article struct from the resource's config
+func galleryArticleFromConfig(d *schema.ResourceData) *structs.GalleryArticle {
+	return &structs.GalleryArticle{
+		CollectionId: d.Get("collection_id").(string),
+		CardId:       d.Get("card_id").(string),
+		ExhibitId:    d.Get("exhibit_id").(string),
+		Name:         d.Get("name").(string),
+		Summary:      d.Get("summary").(string),
+		Description:  d.Get("description").(string),
+		Move:         d.Get("move").(int),
+		Inject:       d.Get("inject").(int),
+		SourceType:   d.Get("source_type").(string),
+		SourceName:   d.Get("source_name").(string),
+		Url:          d.Get("url").(string),
+		DatePosted:   d.Get("date_posted").(string),
+		OpenInNewTab: d.Get("open_in_new_tab").(bool),
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A card in a Gallery collection. A card is only visible to the teams listed in its team blocks, from the move and
+// inject given for each team.
+func galleryCard() *schema.Resource {
+	return &schema.Resource{
+		Create: galleryCardCreate,
+		Read:   galleryCardRead,
+		Update: galleryCardUpdate,
+		Delete: galleryCardDelete,
+
+		Schema: map[string]*schema.Schema{
+			"collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"move": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"inject": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"team": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"team_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"move": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"inject": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"is_shown_on_wall": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"can_post_articles": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get card properties from d
+// Call API to create card, then show it to its teams
+// Call read to make sure everything worked
+func galleryCardCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateGalleryCard(galleryCardFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	err = syncGalleryTeamCards(d, casted)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! Gallery card created with ID %s", d.Id())
+	return galleryCardRead(d, m)
+}
+
+// Check if card exists. If not, set id to "" and return nil
+// Read card info and the teams it is shown to from API
+// Use it to update local state
+func galleryCardRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryCardExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	card, err := api.ReadGalleryCard(id, casted)
+	if err != nil {
+		return err
+	}
+
+	teamCards, err := api.ListGalleryTeamCards(id, casted)
+	if err != nil {
+		return err
+	}
+
+	localTeams := make([]map[string]interface{}, 0, len(teamCards))
+	for _, teamCard := range teamCards {
+		localTeams = append(localTeams, map[string]interface{}{
+			"id":                teamCard.Id,
+			"team_id":           teamCard.TeamId,
+			"move":              teamCard.Move,
+			"inject":            teamCard.Inject,
+			"is_shown_on_wall":  teamCard.IsShownOnWall,
+			"can_post_articles": teamCard.CanPostArticles,
+		})
+	}
+
+	err = d.Set("collection_id", card.CollectionId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", card.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", card.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("move", card.Move)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("inject", card.Inject)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("team", localTeams)
+}
+
+// Get card properties from d
+// Call API to update card, then change which teams it is shown to to match config
+// Call read to make sure everything worked
+func galleryCardUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("name", "description", "move", "inject") {
+		card := galleryCardFromConfig(d)
+		card.Id = d.Id()
+
+		err := api.UpdateGalleryCard(card, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("team") {
+		err := syncGalleryTeamCards(d, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return galleryCardRead(d, m)
+}
+
+// Check if card exists
+// Call API to delete it
+func galleryCardDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryCardExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteGalleryCard(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a card struct from the resource's config
+func galleryCardFromConfig(d *schema.ResourceData) *structs.GalleryCard {
+	return &structs.GalleryCard{
+		CollectionId: d.Get("collection_id").(string),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Move:         d.Get("move").(int),
+		Inject:       d.Get("inject").(int),
+	}
+}
+
+// Creates, updates and deletes the team cards of the card to match config. Team cards are matched by team ID.
+func syncGalleryTeamCards(d *schema.ResourceData, m map[string]string) error {
+	existing, err := api.ListGalleryTeamCards(d.Id(), m)
+	if err != nil {
+		return err
+	}
+
+	byTeam := make(map[string]structs.GalleryTeamCard)
+	for _, teamCard := range existing {
+		byTeam[teamCard.TeamId] = teamCard
+	}
+
+	wanted := make(map[string]bool)
+	for _, raw := range d.Get("team").(*schema.Set).List() {
+		asMap := raw.(map[string]interface{})
+		teamCard := structs.GalleryTeamCard{
+			TeamId:          asMap["team_id"].(string),
+			CardId:          d.Id(),
+			Move:            asMap["move"].(int),
+			Inject:          asMap["inject"].(int),
+			IsShownOnWall:   asMap["is_shown_on_wall"].(bool),
+			CanPostArticles: asMap["can_post_articles"].(bool),
+		}
+
+		if wanted[teamCard.TeamId] {
+			return fmt.Errorf("card is shown to team %s more than once", teamCard.TeamId)
+		}
+		wanted[teamCard.TeamId] = true
+
+		if old, ok := byTeam[teamCard.TeamId]; ok {
+			teamCard.Id = old.Id
+			if old != teamCard {
+				err = api.UpdateGalleryTeamCard(&teamCard, m)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		_, err = api.CreateGalleryTeamCard(&teamCard, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, teamCard := range existing {
+		if !wanted[teamCard.TeamId] {
+			err = api.DeleteGalleryTeamCard(teamCard.Id, m)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A Gallery collection. Cards and articles are added to it with crucible_gallery_card and crucible_gallery_article,
+// and it is shown to teams through a crucible_gallery_exhibit.
+func galleryCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: galleryCollectionCreate,
+		Read:   galleryCollectionRead,
+		Update: galleryCollectionUpdate,
+		Delete: galleryCollectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Get collection properties from d
+// Call API to create collection
+// Call read to make sure everything worked
+func galleryCollectionCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateGalleryCollection(galleryCollectionFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Gallery collection created with ID %s", d.Id())
+	return galleryCollectionRead(d, m)
+}
+
+// Check if collection exists. If not, set id to "" and return nil
+// Read collection info from API
+// Use it to update local state
+func galleryCollectionRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryCollectionExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	collection, err := api.ReadGalleryCollection(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", collection.Name)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("description", collection.Description)
+}
+
+// Get collection properties from d
+// Call API to update collection
+// Call read to make sure everything worked
+func galleryCollectionUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	collection := galleryCollectionFromConfig(d)
+	collection.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateGalleryCollection(collection, casted)
+	if err != nil {
+		return err
+	}
+
+	return galleryCollectionRead(d, m)
+}
+
+// Check if collection exists
+// Call API to delete it. Gallery deletes the collection's cards and articles with it.
+func galleryCollectionDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryCollectionExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteGalleryCollection(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a collection struct from the resource's config
+func galleryCollectionFromConfig(d *schema.ResourceData) *structs.GalleryCollection {
+	return &structs.GalleryCollection{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of a collection, an exhibit of it, a card and an article against a stub of
+// the Gallery API
+//
+// Execution steps
+// 1. Terraform creates a collection, an exhibit with two teams, a card shown to both teams and an article on the card
+// 2. Verify local state and the team cards in the stub
+// 3. Terraform renames the collection, stops showing the card to one team, lets the other post articles and moves
+// the article to a later inject
+// 4. Verify the team card was updated in place and the other was deleted
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// Resources are created, updated, and destroyed without error. Team blocks can be given in any order, and team cards
+// are matched by team ID.
+func TestGalleryCollection(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var teamCardID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			// Gallery deletes the teams of an exhibit and the team cards of a card with them, which the stub doesn't
+			for _, collection := range []string{"collections", "exhibits", "cards", "articles"} {
+				if stub.count(collection) != 0 {
+					return fmt.Errorf("expected no %s after destroy, found %d", collection, stub.count(collection))
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configGalleryCollection("Exercise", 1, `
+					team {
+						team_id = local.team_ids["White Cell"]
+					}
+					team {
+						team_id = local.team_ids["Blue Team"]
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_gallery_exhibit.test", "team.#", "2"),
+					resource.TestCheckResourceAttr("crucible_gallery_card.test", "name", "Finance department"),
+					resource.TestCheckResourceAttr("crucible_gallery_card.test", "team.#", "2"),
+					resource.TestCheckResourceAttr("crucible_gallery_article.test", "inject", "1"),
+					resource.TestCheckResourceAttr("crucible_gallery_article.test", "date_posted", "2022-03-01T12:00:00Z"),
+					func(s *terraform.State) error {
+						blue := stub.find("teams", "name", "Blue Team")
+						if len(blue) != 1 || stub.count("teamCards") != 2 {
+							return fmt.Errorf("expected 2 team cards in the stub, found %d", stub.count("teamCards"))
+						}
+						teamCardID = stub.find("teamCards", "teamId", blue[0]["id"].(string))[0]["id"].(string)
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configGalleryCollection("Exercise 2", 2, `
+					team {
+						team_id           = local.team_ids["Blue Team"]
+						can_post_articles = true
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_gallery_collection.test", "name", "Exercise 2"),
+					resource.TestCheckResourceAttr("crucible_gallery_card.test", "team.#", "1"),
+					resource.TestCheckResourceAttr("crucible_gallery_article.test", "inject", "2"),
+					func(s *terraform.State) error {
+						teamCards := stub.find("teamCards", "canPostArticles", "true")
+						if stub.count("teamCards") != 1 || len(teamCards) != 1 || teamCards[0]["id"] != teamCardID {
+							return fmt.Errorf("expected the Blue Team card to be updated in place, found %v", teamCards)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configGalleryCollection(name string, inject int, teams string) string {
+	return fmt.Sprintf(`
+	resource "crucible_gallery_collection" "test" {
+		name = "%s"
+	}
+
+	resource "crucible_gallery_exhibit" "test" {
+		collection_id = crucible_gallery_collection.test.id
+
+		team {
+			name       = "Blue Team"
+			short_name = "BLUE"
+		}
+
+		team {
+			name       = "White Cell"
+			short_name = "WHITE"
+		}
+	}
+
+	locals {
+		team_ids = { for team in crucible_gallery_exhibit.test.team : team.name => team.id }
+	}
+
+	resource "crucible_gallery_card" "test" {
+		collection_id = crucible_gallery_collection.test.id
+		name          = "Finance department"
+		%s
+	}
+
+	resource "crucible_gallery_article" "test" {
+		collection_id = crucible_gallery_collection.test.id
+		card_id       = crucible_gallery_card.test.id
+		name          = "Phishing email"
+		inject        = %d
+		date_posted   = "2022-03-01T12:00:00Z"
+	}
+	`, name, teams, inject)
+}
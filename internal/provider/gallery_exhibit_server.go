@@ -0,0 +1,292 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A Gallery exhibit with the teams that view it. Teams are matched to the ones in Gallery by name. Set current_move
+// and current_inject to release the articles timed for that point in the exercise.
+func galleryExhibit() *schema.Resource {
+	return &schema.Resource{
+		Create: galleryExhibitCreate,
+		Read:   galleryExhibitRead,
+		Update: galleryExhibitUpdate,
+		Delete: galleryExhibitDelete,
+
+		Schema: map[string]*schema.Schema{
+			"collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scenario_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"current_move": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"current_inject": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"team": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"short_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get exhibit properties from d
+// Call API to create exhibit, then its teams
+// Call read to make sure everything worked
+func galleryExhibitCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateGalleryExhibit(galleryExhibitFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	err = syncGalleryTeams(d, casted)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! Gallery exhibit created with ID %s", d.Id())
+	return galleryExhibitRead(d, m)
+}
+
+// Check if exhibit exists. If not, set id to "" and return nil
+// Read exhibit info and its teams from API
+// Use it to update local state
+func galleryExhibitRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryExhibitExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	exhibit, err := api.ReadGalleryExhibit(id, casted)
+	if err != nil {
+		return err
+	}
+
+	teams, err := api.ListGalleryTeams(id, casted)
+	if err != nil {
+		return err
+	}
+
+	localTeams := make([]map[string]interface{}, 0, len(teams))
+	for _, team := range teams {
+		localTeams = append(localTeams, map[string]interface{}{
+			"id":         team.Id,
+			"name":       team.Name,
+			"short_name": team.ShortName,
+		})
+	}
+
+	err = d.Set("collection_id", exhibit.CollectionId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scenario_id", exhibit.ScenarioId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("current_move", exhibit.CurrentMove)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("current_inject", exhibit.CurrentInject)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("team", localTeams)
+}
+
+// Get exhibit properties from d
+// Call API to update exhibit, then create, update or delete its teams to match config
+// Call read to make sure everything worked
+func galleryExhibitUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("scenario_id", "current_move", "current_inject") {
+		exhibit := galleryExhibitFromConfig(d)
+		exhibit.Id = d.Id()
+
+		err := api.UpdateGalleryExhibit(exhibit, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("team") {
+		err := syncGalleryTeams(d, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return galleryExhibitRead(d, m)
+}
+
+// Check if exhibit exists
+// Call API to delete it. Gallery deletes the exhibit's teams with it.
+func galleryExhibitDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gallery_api_url", "Gallery")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GalleryExhibitExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteGalleryExhibit(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds an exhibit struct from the resource's config
+func galleryExhibitFromConfig(d *schema.ResourceData) *structs.GalleryExhibit {
+	return &structs.GalleryExhibit{
+		CollectionId:  d.Get("collection_id").(string),
+		ScenarioId:    d.Get("scenario_id").(string),
+		CurrentMove:   d.Get("current_move").(int),
+		CurrentInject: d.Get("current_inject").(int),
+	}
+}
+
+// Creates, updates and deletes the teams of the exhibit to match config. Teams are matched by name.
+func syncGalleryTeams(d *schema.ResourceData, m map[string]string) error {
+	existing, err := api.ListGalleryTeams(d.Id(), m)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]structs.GalleryTeam)
+	for _, team := range existing {
+		byName[team.Name] = team
+	}
+
+	wanted := make(map[string]bool)
+	for _, raw := range d.Get("team").(*schema.Set).List() {
+		asMap := raw.(map[string]interface{})
+		team := structs.GalleryTeam{
+			ExhibitId: d.Id(),
+			Name:      asMap["name"].(string),
+			ShortName: asMap["short_name"].(string),
+		}
+
+		if wanted[team.Name] {
+			return fmt.Errorf("exhibit has more than one team named %q", team.Name)
+		}
+		wanted[team.Name] = true
+
+		if old, ok := byName[team.Name]; ok {
+			team.Id = old.Id
+			if old != team {
+				err = api.UpdateGalleryTeam(&team, m)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		_, err = api.CreateGalleryTeam(&team, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, team := range existing {
+		if !wanted[team.Name] {
+			err = api.DeleteGalleryTeam(team.Id, m)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
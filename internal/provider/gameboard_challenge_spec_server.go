@@ -0,0 +1,224 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A challenge in a Gameboard game. The challenge is played in a gamespace deployed from the TopoMojo workspace it
+// points at.
+func gameboardChallengeSpec() *schema.Resource {
+	return &schema.Resource{
+		Create: gameboardChallengeSpecCreate,
+		Read:   gameboardChallengeSpecRead,
+		Update: gameboardChallengeSpecUpdate,
+		Delete: gameboardChallengeSpecDelete,
+
+		Schema: map[string]*schema.Schema{
+			"game_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"game_engine_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "TopoMojo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"points": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Get challenge spec properties from d
+// Call API to create challenge spec
+// Call read to make sure everything worked
+func gameboardChallengeSpecCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateGameboardChallengeSpec(gameboardChallengeSpecFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Gameboard challenge spec created with ID %s", d.Id())
+	return gameboardChallengeSpecRead(d, m)
+}
+
+// Check if challenge spec exists. If not, set id to "" and return nil
+// Read challenge spec info from API
+// Use it to update local state
+func gameboardChallengeSpecRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GameboardChallengeSpecExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	spec, err := api.ReadGameboardChallengeSpec(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("game_id", spec.GameId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("workspace_id", spec.ExternalId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("game_engine_type", spec.GameEngineType)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", spec.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", spec.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("tag", spec.Tag)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("points", spec.Points)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("disabled", spec.Disabled)
+}
+
+// Get challenge spec properties from d
+// Call API to update challenge spec
+// Call read to make sure everything worked
+func gameboardChallengeSpecUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	spec := gameboardChallengeSpecFromConfig(d)
+	spec.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateGameboardChallengeSpec(spec, casted)
+	if err != nil {
+		return err
+	}
+
+	return gameboardChallengeSpecRead(d, m)
+}
+
+// Check if challenge spec exists
+// Call API to delete it
+func gameboardChallengeSpecDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GameboardChallengeSpecExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteGameboardChallengeSpec(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a challenge spec struct from the resource's config
+func gameboardChallengeSpecFromConfig(d *schema.ResourceData) *structs.GameboardChallengeSpec {
+	return &structs.GameboardChallengeSpec{
+		GameId:         d.Get("game_id").(string),
+		ExternalId:     d.Get("workspace_id").(string),
+		GameEngineType: d.Get("game_engine_type").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Tag:            d.Get("tag").(string),
+		Points:         d.Get("points").(int),
+		Disabled:       d.Get("disabled").(bool),
+	}
+}
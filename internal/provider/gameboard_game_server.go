@@ -0,0 +1,362 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// How players can register teams for a Gameboard game
+var gameboardRegistrationTypes = []string{"None", "Open"}
+
+// A Gameboard game. Challenges are added to it with crucible_gameboard_challenge_spec.
+func gameboardGame() *schema.Resource {
+	return &schema.Resource{
+		Create: gameboardGameCreate,
+		Read:   gameboardGameRead,
+		Update: gameboardGameUpdate,
+		Delete: gameboardGameDelete,
+
+		CustomizeDiff: gameboardGameCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"competition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"season": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"track": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"division": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"game_start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"game_end": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"registration_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "None",
+				ValidateFunc: validation.StringInSlice(gameboardRegistrationTypes, false),
+			},
+			"registration_open": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"registration_close": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"min_team_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_team_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"session_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"session_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"gamespace_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"is_published": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Get game properties from d
+// Call API to create game
+// Call read to make sure everything worked
+func gameboardGameCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateGameboardGame(gameboardGameFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Gameboard game created with ID %s", d.Id())
+	return gameboardGameRead(d, m)
+}
+
+// Check if game exists. If not, set id to "" and return nil
+// Read game info from API
+// Use it to update local state
+func gameboardGameRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GameboardGameExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	game, err := api.ReadGameboardGame(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", game.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("competition", game.Competition)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("season", game.Season)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("track", game.Track)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("division", game.Division)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("game_start", game.GameStart)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("game_end", game.GameEnd)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("registration_type", game.RegistrationType)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("registration_open", game.RegistrationOpen)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("registration_close", game.RegistrationClose)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("min_team_size", game.MinTeamSize)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("max_team_size", game.MaxTeamSize)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("session_minutes", game.SessionMinutes)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("session_limit", game.SessionLimit)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("gamespace_limit", game.GamespaceLimitPerSession)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("max_attempts", game.MaxAttempts)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("is_published", game.IsPublished)
+}
+
+// Get game properties from d
+// Call API to update game
+// Call read to make sure everything worked
+func gameboardGameUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	game := gameboardGameFromConfig(d)
+	game.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateGameboardGame(game, casted)
+	if err != nil {
+		return err
+	}
+
+	return gameboardGameRead(d, m)
+}
+
+// Check if game exists
+// Call API to delete it. Gameboard deletes the game's challenge specs with it.
+func gameboardGameDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "gameboard_api_url", "Gameboard")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.GameboardGameExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteGameboardGame(id, casted)
+}
+
+// Refuse plans that Gameboard would reject or that would leave a game no team can play: a minimum team size above the
+// maximum, or a window that closes before it opens
+func gameboardGameCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("min_team_size") && d.NewValueKnown("max_team_size") {
+		minSize, maxSize := d.Get("min_team_size").(int), d.Get("max_team_size").(int)
+		if minSize > maxSize {
+			return fmt.Errorf("min_team_size (%d) must not be greater than max_team_size (%d)", minSize, maxSize)
+		}
+	}
+
+	for _, window := range [][2]string{{"game_start", "game_end"}, {"registration_open", "registration_close"}} {
+		if !d.NewValueKnown(window[0]) || !d.NewValueKnown(window[1]) {
+			continue
+		}
+		if !util.TimesInOrder(d.Get(window[0]).(string), d.Get(window[1]).(string)) {
+			return fmt.Errorf("%s must not be after %s", window[0], window[1])
+		}
+	}
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a game struct from the resource's config
+func gameboardGameFromConfig(d *schema.ResourceData) *structs.GameboardGame {
+	return &structs.GameboardGame{
+		Name:                     d.Get("name").(string),
+		Competition:              d.Get("competition").(string),
+		Season:                   d.Get("season").(string),
+		Track:                    d.Get("track").(string),
+		Division:                 d.Get("division").(string),
+		GameStart:                d.Get("game_start").(string),
+		GameEnd:                  d.Get("game_end").(string),
+		RegistrationType:         d.Get("registration_type").(string),
+		RegistrationOpen:         d.Get("registration_open").(string),
+		RegistrationClose:        d.Get("registration_close").(string),
+		MinTeamSize:              d.Get("min_team_size").(int),
+		MaxTeamSize:              d.Get("max_team_size").(int),
+		SessionMinutes:           d.Get("session_minutes").(int),
+		SessionLimit:             d.Get("session_limit").(int),
+		GamespaceLimitPerSession: d.Get("gamespace_limit").(int),
+		MaxAttempts:              d.Get("max_attempts").(int),
+		IsPublished:              d.Get("is_published").(bool),
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of a game and a challenge spec in it against a stub of the Gameboard API
+//
+// Execution steps
+// 1. Terraform creates a game and a challenge spec pointing at a TopoMojo workspace
+// 2. Verify local state and the challenge spec in the stub
+// 3. Terraform opens registration for teams of two to four and raises the challenge's points
+// 4. Verify the game and challenge spec were updated in place
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// Resources are created, updated, and destroyed without error
+func TestGameboardGame(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var gameID, specID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("game") != 0 || stub.count("challengespec") != 0 {
+				return fmt.Errorf("expected no games or challenge specs after destroy, found %d and %d",
+					stub.count("game"), stub.count("challengespec"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configGameboardGame("None", 1, 1, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_gameboard_game.test", "registration_type", "None"),
+					resource.TestCheckResourceAttr("crucible_gameboard_game.test", "session_minutes", "60"),
+					resource.TestCheckResourceAttr("crucible_gameboard_game.test", "game_start",
+						"2026-11-02T13:00:00Z"),
+					resource.TestCheckResourceAttr("crucible_gameboard_challenge_spec.test", "game_engine_type",
+						"TopoMojo"),
+					resource.TestCheckResourceAttr("crucible_gameboard_challenge_spec.test", "points", "100"),
+					func(s *terraform.State) error {
+						gameID = s.RootModule().Resources["crucible_gameboard_game.test"].Primary.ID
+						specID = s.RootModule().Resources["crucible_gameboard_challenge_spec.test"].Primary.ID
+						if len(stub.find("challengespec", "externalId", "6f1d2c3b-4a59-4e68-9d7c-8b9a0f1e2d3c")) != 1 {
+							return fmt.Errorf("expected a challenge spec for the workspace in the stub")
+						}
+						if len(stub.find("challengespec", "gameId", gameID)) != 1 {
+							return fmt.Errorf("expected a challenge spec for the game in the stub")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configGameboardGame("Open", 2, 4, 250),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_gameboard_game.test", "registration_type", "Open"),
+					resource.TestCheckResourceAttr("crucible_gameboard_game.test", "min_team_size", "2"),
+					resource.TestCheckResourceAttr("crucible_gameboard_game.test", "max_team_size", "4"),
+					resource.TestCheckResourceAttr("crucible_gameboard_challenge_spec.test", "points", "250"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_gameboard_game.test"].Primary.ID != gameID {
+							return fmt.Errorf("expected the game to be updated in place")
+						}
+						if s.RootModule().Resources["crucible_gameboard_challenge_spec.test"].Primary.ID != specID {
+							return fmt.Errorf("expected the challenge spec to be updated in place")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// Test case for planning a game that no team could play
+//
+// Execution steps
+// 1. Terraform plans a game with a minimum team size above the maximum
+// 2. Terraform plans a game that ends before it starts
+//
+// Expected behavior:
+// Both plans fail before anything is sent to Gameboard
+func TestGameboardGameInvalid(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      stub.providerConfig() + configGameboardGame("Open", 4, 2, 100),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("min_team_size \\(4\\) must not be greater than max_team_size \\(2\\)"),
+			},
+			{
+				Config: stub.providerConfig() + `
+				resource "crucible_gameboard_game" "test" {
+					name       = "Qualifier"
+					game_start = "2026-11-06T21:00:00Z"
+					game_end   = "2026-11-02T13:00:00Z"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("game_start must not be after game_end"),
+			},
+		},
+	})
+}
+
+func configGameboardGame(registration string, minTeamSize, maxTeamSize, points int) string {
+	return fmt.Sprintf(`
+	resource "crucible_gameboard_game" "test" {
+		name              = "Qualifier"
+		competition       = "Cyber Cup"
+		game_start        = "2026-11-02T13:00:00Z"
+		game_end          = "2026-11-06T21:00:00Z"
+		registration_type = "%s"
+		min_team_size     = %d
+		max_team_size     = %d
+	}
+
+	resource "crucible_gameboard_challenge_spec" "test" {
+		game_id      = crucible_gameboard_game.test.id
+		workspace_id = "6f1d2c3b-4a59-4e68-9d7c-8b9a0f1e2d3c"
+		name         = "Network forensics"
+		points       = %d
+	}
+	`, registration, minTeamSize, maxTeamSize, points)
+}
@@ -0,0 +1,228 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The roles an identity account can have
+var identityAccountRoles = []string{"Member", "Manager", "Administrator"}
+
+// An account in the Crucible identity server. Its global_id is the subject of the account's tokens and can be used as
+// the user_id of a crucible_player_user.
+func identityAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: identityAccountCreate,
+		Read:   identityAccountRead,
+		Update: identityAccountUpdate,
+		Delete: identityAccountDelete,
+
+		CustomizeDiff: identityAccountCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Member",
+				ValidateFunc: validation.StringInSlice(identityAccountRoles, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"global_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Get account properties from d
+// Call API to create account
+// Call read to make sure everything worked
+func identityAccountCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateIdentityAccount(identityAccountFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(created.Id))
+
+	log.Printf("! Identity account created with ID %s", d.Id())
+	return identityAccountRead(d, m)
+}
+
+// Check if account exists. If not, set id to "" and return nil
+// Read account info from API
+// Use it to update local state
+func identityAccountRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.IdentityAccountExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	account, err := api.ReadIdentityAccount(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("username", account.Username)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("display_name", account.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("role", account.Role)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("enabled", account.Status == "Enabled")
+	if err != nil {
+		return err
+	}
+
+	return d.Set("global_id", account.GlobalId)
+}
+
+// Get account properties from d
+// Call API to update account. The password is only sent when it changed.
+// Call read to make sure everything worked
+func identityAccountUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account := identityAccountFromConfig(d)
+	account.Id = id
+	if !d.HasChange("password") {
+		account.Password = ""
+	}
+
+	casted := m.(map[string]string)
+	err = util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateIdentityAccount(account, casted)
+	if err != nil {
+		return err
+	}
+
+	return identityAccountRead(d, m)
+}
+
+// Check if account exists
+// Call API to delete it
+func identityAccountDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.IdentityAccountExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteIdentityAccount(id, casted)
+}
+
+// Replace the account when its password is removed. An update leaves the password out when it is empty, so the
+// account would otherwise keep signing in with the old one.
+func identityAccountCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("password") {
+		return nil
+	}
+
+	oldPassword, newPassword := d.GetChange("password")
+	if oldPassword.(string) != "" && newPassword.(string) == "" {
+		return d.ForceNew("password")
+	}
+	return nil
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds an account struct from the resource's config
+func identityAccountFromConfig(d *schema.ResourceData) *structs.IdentityAccount {
+	return &structs.IdentityAccount{
+		Username:    d.Get("username").(string),
+		Password:    d.Get("password").(string),
+		DisplayName: d.Get("display_name").(string),
+		Role:        d.Get("role").(string),
+		Status:      util.Ternary(d.Get("enabled").(bool), "Enabled", "Disabled").(string),
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of an account against a stub of the Identity API
+//
+// Execution steps
+// 1. Terraform creates an account with a password
+// 2. Terraform renames the account
+// 3. Verify the update left the password out, so the account kept it
+// 4. Terraform sets a new password
+// 5. Verify the new password was sent and the account was updated in place
+// 6. Terraform removes the password
+// 7. Verify the account was replaced
+// 8. Terraform destroys the account
+//
+// Expected behavior:
+// The password is only sent when it changes, and removing it replaces the account
+func TestIdentityAccount(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	// The passwords sent in updates, with "" for updates that left it out
+	var sentPasswords []string
+	stub.handle("PUT", "account/{id}", func(id string, body []byte) (int, interface{}) {
+		account := stub.get("account", id)
+		if account == nil {
+			return http.StatusNotFound, nil
+		}
+		updated := make(map[string]interface{})
+		if err := json.Unmarshal(body, &updated); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		password, _ := updated["password"].(string)
+		sentPasswords = append(sentPasswords, password)
+		for key, value := range updated {
+			if key != "id" {
+				account[key] = value
+			}
+		}
+		return http.StatusOK, account
+	})
+
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("account") != 0 {
+				return fmt.Errorf("expected no accounts after destroy, found %d", stub.count("account"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configIdentityAccount("Analyst", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_identity_account.test", "role", "Member"),
+					resource.TestCheckResourceAttr("crucible_identity_account.test", "enabled", "true"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["crucible_identity_account.test"].Primary.ID
+						if len(stub.find("account", "password", "first")) != 1 {
+							return fmt.Errorf("expected the password to be sent when the account was created")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configIdentityAccount("Analyst 2", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_identity_account.test", "display_name", "Analyst 2"),
+					func(s *terraform.State) error {
+						if len(sentPasswords) != 1 || sentPasswords[0] != "" {
+							return fmt.Errorf("expected one update without a password, found %q", sentPasswords)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configIdentityAccount("Analyst 2", "second"),
+				Check: func(s *terraform.State) error {
+					if len(sentPasswords) != 2 || sentPasswords[1] != "second" {
+						return fmt.Errorf("expected an update with the new password, found %q", sentPasswords)
+					}
+					if s.RootModule().Resources["crucible_identity_account.test"].Primary.ID != id {
+						return fmt.Errorf("expected the account to be updated in place")
+					}
+					return nil
+				},
+			},
+			{
+				Config: stub.providerConfig() + configIdentityAccount("Analyst 2", ""),
+				Check: func(s *terraform.State) error {
+					if s.RootModule().Resources["crucible_identity_account.test"].Primary.ID == id {
+						return fmt.Errorf("expected the account to be replaced when its password was removed")
+					}
+					if stub.count("account") != 1 {
+						return fmt.Errorf("expected the old account to be deleted, found %d", stub.count("account"))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func configIdentityAccount(displayName, password string) string {
+	return fmt.Sprintf(`
+	resource "crucible_identity_account" "test" {
+		username     = "analyst@example.com"
+		password     = "%s"
+		display_name = "%s"
+	}
+	`, password, displayName)
+}
@@ -0,0 +1,246 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// An OAuth client registered in the Crucible identity server. A secret is generated for the client when it is created.
+func identityClient() *schema.Resource {
+	return &schema.Resource{
+		Create: identityClientCreate,
+		Read:   identityClientRead,
+		Update: identityClientUpdate,
+		Delete: identityClientDelete,
+
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"grants": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"redirect_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// Get client properties from d
+// Call API to create client, then generate its secret
+// Call read to make sure everything worked
+func identityClientCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateIdentityClient(identityClientFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(created.Id))
+
+	// The identity server only returns a secret when it is generated, so it is kept in state from here on
+	secret, err := api.NewIdentityClientSecret(d.Id(), casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("secret", secret)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("! Identity client created with ID %s", d.Id())
+	return identityClientRead(d, m)
+}
+
+// Check if client exists. If not, set id to "" and return nil
+// Read client info from API
+// Use it to update local state
+func identityClientRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.IdentityClientExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	identityClient, err := api.ReadIdentityClient(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("client_id", identityClient.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("display_name", identityClient.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", identityClient.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("grants", strings.Fields(identityClient.Grants))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scopes", strings.Fields(identityClient.Scopes))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("redirect_urls", identityClient.RedirectUrls)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("enabled", identityClient.Enabled)
+}
+
+// Get client properties from d
+// Call API to update client
+// Call read to make sure everything worked
+func identityClientUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	identityClient := identityClientFromConfig(d)
+	identityClient.Id = id
+
+	casted := m.(map[string]string)
+	err = util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateIdentityClient(identityClient, casted)
+	if err != nil {
+		return err
+	}
+
+	return identityClientRead(d, m)
+}
+
+// Check if client exists
+// Call API to delete it
+func identityClientDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "identity_api_url", "Identity")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.IdentityClientExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteIdentityClient(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a client struct from the resource's config
+func identityClientFromConfig(d *schema.ResourceData) *structs.IdentityClient {
+	grants := d.Get("grants").([]interface{})
+	scopes := d.Get("scopes").([]interface{})
+	redirectUrls := d.Get("redirect_urls").([]interface{})
+
+	return &structs.IdentityClient{
+		Name:         d.Get("client_id").(string),
+		DisplayName:  d.Get("display_name").(string),
+		Description:  d.Get("description").(string),
+		Grants:       strings.Join(*util.ToStringSlice(&grants), " "),
+		Scopes:       strings.Join(*util.ToStringSlice(&scopes), " "),
+		RedirectUrls: *util.ToStringSlice(&redirectUrls),
+		Enabled:      d.Get("enabled").(bool),
+	}
+}
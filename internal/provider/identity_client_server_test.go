@@ -0,0 +1,101 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of an OAuth client against a stub of the Identity API
+//
+// Execution steps
+// 1. Terraform creates a client, and the stub generates a secret for it
+// 2. Verify local state, including the secret
+// 3. Terraform adds a scope and disables the client
+// 4. Verify the client was updated in place and kept its secret
+// 5. Terraform destroys the client
+//
+// Expected behavior:
+// The client is created, updated, and destroyed without error, and its secret is only generated once
+func TestIdentityClient(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	secrets := 0
+	stub.handle("POST", "client/{id}/secret", func(id string, body []byte) (int, interface{}) {
+		if stub.get("client", id) == nil {
+			return http.StatusNotFound, nil
+		}
+		secrets++
+		return http.StatusOK, fmt.Sprintf("secret-%d", secrets)
+	})
+
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("client") != 0 {
+				return fmt.Errorf("expected no clients after destroy, found %d", stub.count("client"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configIdentityClient(`"player-api"`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "client_id", "range-automation"),
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "grants.#", "1"),
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "scopes.0", "player-api"),
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "secret", "secret-1"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["crucible_identity_client.test"].Primary.ID
+						if len(stub.find("client", "scopes", "player-api")) != 1 {
+							return fmt.Errorf("expected the scopes to be sent as a space separated list")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configIdentityClient(`"player-api", "vm-api"`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "scopes.#", "2"),
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "scopes.1", "vm-api"),
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("crucible_identity_client.test", "secret", "secret-1"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_identity_client.test"].Primary.ID != id {
+							return fmt.Errorf("expected the client to be updated in place")
+						}
+						if len(stub.find("client", "scopes", "player-api vm-api")) != 1 {
+							return fmt.Errorf("expected the new scopes to be sent as a space separated list")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configIdentityClient(scopes string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "crucible_identity_client" "test" {
+		client_id    = "range-automation"
+		display_name = "Range automation"
+		grants       = ["client_credentials"]
+		scopes       = [%s]
+		enabled      = %t
+	}
+	`, scopes, enabled)
+}
@@ -9,14 +9,20 @@ import (
 	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
 	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
 	"log"
+	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
+// The power states the VM API reports for a VM whose power state can be managed
+var vmReportedPowerStates = []string{"on", "off", "suspended"}
+
 // Maps the required operations to the functions defined below.
 // The map of strings to Schema pointers defines the properties of a resource.
 func playerVirtualMachine() *schema.Resource {
@@ -26,6 +32,13 @@ func playerVirtualMachine() *schema.Resource {
 		Update: playerVirtualMachineUpdate,
 		Delete: playerVirtualMachineDelete,
 
+		// Only used when waiting for power state changes
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vm_id": {
 				Type:     schema.TypeString,
@@ -100,6 +113,13 @@ func playerVirtualMachine() *schema.Resource {
 							Optional: true,
 						},
 						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						// Lets the password be kept out of config files. It is still stored in state when read
+						// from the API unless this is set
+						"password_env": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
@@ -116,14 +136,34 @@ func playerVirtualMachine() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							ForceNew: true,
+							ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+								if _, err := structs.ParseProxmoxReference(v.(string)); err != nil {
+									return nil, []error{fmt.Errorf("%s: %v", k, err)}
+								}
+								return nil, nil
+							},
+							// The API only stores the integer ID, so a {node}/{type}/{id} reference is unchanged
+							// as long as it points to the same node and type that are in state
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								idTokens := strings.Split(new, "/")
-								return len(idTokens) == 3 && idTokens[2] == old
+								oldRef, err := structs.ParseProxmoxReference(old)
+								if err != nil {
+									return false
+								}
+								newRef, err := structs.ParseProxmoxReference(new)
+								if err != nil || newRef.Id != oldRef.Id {
+									return false
+								}
+								if newRef.Node == "" {
+									return true
+								}
+								return newRef.Node == d.Get("proxmox_vm_info.0.node").(string) &&
+									strings.EqualFold(newRef.Type, d.Get("proxmox_vm_info.0.type").(string))
 							},
 						},
 						"node": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 						},
 						"type": {
 							Type:         schema.TypeString,
@@ -131,6 +171,47 @@ func playerVirtualMachine() *schema.Resource {
 							Default:      "QEMU",
 							ValidateFunc: validation.StringInSlice([]string{"QEMU", "LXC"}, false),
 						},
+						"reference": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"power_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "unmanaged",
+				ValidateFunc: validation.StringInSlice([]string{"on", "off", "unmanaged"}, false),
+			},
+			"power_off_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_for_ready": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"power_state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "on",
+							ValidateFunc: validation.StringInSlice([]string{"on", "off", "suspended", "any"}, false),
+						},
+						"console": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"poll_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "10s",
+							ValidateFunc: util.ValidateDuration,
+						},
 					},
 				},
 			},
@@ -190,23 +271,17 @@ func playerVirtualMachineCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// Grab the console connection info block if one exists
-	connectionGeneric := d.Get("console_connection_info").([]interface{})
-	log.Printf("! In create, console connection info = %v", connectionGeneric)
-	var connection *structs.ConsoleConnection
-	if len(connectionGeneric) > 0 {
-		connection = structs.ConnectionFromMap(connectionGeneric[0].(map[string]interface{}))
-	} else {
-		connection = nil
+	log.Printf("! In create, console connection info = %s", util.Redact(d.Get("console_connection_info")))
+	connection, err := connectionFromConfig(d)
+	if err != nil {
+		return err
 	}
 
 	// Grab the proxmox vm info block if one exists
-	proxmoxGeneric := d.Get("proxmox_vm_info").([]interface{})
-	log.Printf("! In create, proxmox vm info = %v", proxmoxGeneric)
-	var proxmox *structs.ProxmoxInfo
-	if len(proxmoxGeneric) > 0 {
-		proxmox = structs.ProxmoxInfoFromMap(proxmoxGeneric[0].(map[string]interface{}))
-	} else {
-		proxmox = nil
+	log.Printf("! In create, proxmox vm info = %v", d.Get("proxmox_vm_info"))
+	proxmox, err := proxmoxFromConfig(d)
+	if err != nil {
+		return err
 	}
 
 	reqBody := &structs.VMInfo{
@@ -219,15 +294,26 @@ func playerVirtualMachineCreate(d *schema.ResourceData, m interface{}) error {
 		Connection: connection,
 		Proxmox:    proxmox,
 	}
-	log.Printf("! VM to be created with the following fields:\n %+v", reqBody)
+	log.Printf("! VM to be created with the following fields:\n %s", util.Redact(reqBody))
 
 	casted := m.(map[string]string)
 	log.Printf("! In create function, calling create API wrapper")
-	err := api.CreateVM(reqBody, casted)
+	err = api.CreateVM(reqBody, casted)
 	if err != nil {
 		return err
 	}
 
+	if proxmox != nil {
+		err = checkProxmoxVM(vmID, proxmox, casted)
+		if err != nil {
+			// Don't leave behind a VM that points to nothing
+			if deleteErr := api.DeleteVM(vmID, casted); deleteErr != nil {
+				log.Printf("! In create function, error deleting VM %s: %v", vmID, deleteErr)
+			}
+			return err
+		}
+	}
+
 	// If no errors occurred, set the properties of d. This tells terraform the resource was created
 	d.SetId(vmID)
 	err = d.Set("url", reqBody.URL)
@@ -251,6 +337,25 @@ func playerVirtualMachineCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// Waiting for the power state and for the VM to be ready share the create timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	err = applyVMPowerState(vmID, d.Get("power_state").(string), time.Until(deadline), casted)
+	if err != nil {
+		return err
+	}
+
+	// Block until the VM API reports the VM as usable, if the config asks us to
+	waitGeneric := d.Get("wait_for_ready").([]interface{})
+	if len(waitGeneric) > 0 && waitGeneric[0] != nil {
+		log.Printf("! In create function, waiting for VM to be ready")
+		err = waitForVMReady(vmID, proxmox == nil && connection == nil, waitGeneric[0].(map[string]interface{}),
+			time.Until(deadline), casted)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("! In create function, calling read function")
 	return playerVirtualMachineRead(d, m)
 }
@@ -288,7 +393,7 @@ func playerVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	log.Printf("! In read, remote state was:\n %+v", info)
+	log.Printf("! In read, remote state was:\n %s", util.Redact(info))
 
 	// Team IDs must be sorted alphabetically to prevent unnecessary updates
 	sort.Slice(info.TeamIDs, func(i, j int) bool {
@@ -326,7 +431,16 @@ func playerVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if info.Connection != nil {
-		err = d.Set("console_connection_info", []interface{}{info.Connection.ToMap()})
+		connectionMap := info.Connection.ToMap()
+
+		// If the password comes from the environment, keep it out of state
+		passwordEnv := d.Get("console_connection_info.0.password_env").(string)
+		if passwordEnv != "" {
+			connectionMap["password"] = ""
+		}
+		connectionMap["password_env"] = passwordEnv
+
+		err = d.Set("console_connection_info", []interface{}{connectionMap})
 		if err != nil {
 			return err
 		}
@@ -340,6 +454,16 @@ func playerVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	// Only track the power state if it is being managed, otherwise it would show up as a diff on every plan. The
+	// state is recorded as reported, even if it is one that can't be configured, so it shows up as drift instead of
+	// failing the refresh. Unusable states are rejected when the power state is applied.
+	if d.Get("power_state").(string) != "unmanaged" {
+		err = d.Set("power_state", strings.ToLower(info.PowerState))
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("! Returning from read function without error")
 	return nil
 }
@@ -437,16 +561,14 @@ func playerVirtualMachineUpdate(d *schema.ResourceData, m interface{}) error {
 		embeddable = true
 	}
 
-	connectionGeneric := d.Get("console_connection_info").([]interface{})
-	var connection *structs.ConsoleConnection
-	if len(connectionGeneric) != 0 {
-		connection = structs.ConnectionFromMap(connectionGeneric[0].(map[string]interface{}))
+	connection, err := connectionFromConfig(d)
+	if err != nil {
+		return err
 	}
 
-	proxmoxGeneric := d.Get("proxmox_vm_info").([]interface{})
-	var proxmox *structs.ProxmoxInfo
-	if len(proxmoxGeneric) != 0 {
-		proxmox = structs.ProxmoxInfoFromMap(proxmoxGeneric[0].(map[string]interface{}))
+	proxmox, err := proxmoxFromConfig(d)
+	if err != nil {
+		return err
 	}
 
 	// The ID and TeamIDs parameters will be ignored by the API.
@@ -463,11 +585,18 @@ func playerVirtualMachineUpdate(d *schema.ResourceData, m interface{}) error {
 
 	casted := m.(map[string]string)
 	log.Printf("! In update function, calling update API wrapper")
-	err := api.UpdateVM(reqBody, d.Id(), casted)
+	err = api.UpdateVM(reqBody, d.Id(), casted)
 	if err != nil {
 		return err
 	}
 
+	if proxmox != nil && d.HasChange("proxmox_vm_info") {
+		err = checkProxmoxVM(d.Id(), proxmox, casted)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Set the local state to reflect the update
 	err = d.Set("url", reqBody.URL)
 	if err != nil {
@@ -482,6 +611,13 @@ func playerVirtualMachineUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if d.HasChange("power_state") {
+		err = applyVMPowerState(d.Id(), d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate), casted)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("! Calling read from update")
 	return playerVirtualMachineRead(d, m)
 }
@@ -515,7 +651,197 @@ func playerVirtualMachineDelete(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 
+	if d.Get("power_off_on_destroy").(bool) {
+		log.Printf("! In delete function, powering off VM")
+		err = applyVMPowerState(id, "off", d.Timeout(schema.TimeoutDelete), casted)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("! In delete function, calling delete API wrapper")
 	// We can return the result of the function call directly because it is nil on success or some error value on failure
 	return api.DeleteVM(id, casted)
 }
+
+// Builds the console connection info to send to the API from the console_connection_info block, if there is one.
+// If password_env is set, the password is read from that environment variable instead of the config.
+func connectionFromConfig(d *schema.ResourceData) (*structs.ConsoleConnection, error) {
+	connectionGeneric := d.Get("console_connection_info").([]interface{})
+	if len(connectionGeneric) == 0 || connectionGeneric[0] == nil {
+		return nil, nil
+	}
+
+	asMap := connectionGeneric[0].(map[string]interface{})
+	connection := structs.ConnectionFromMap(asMap)
+
+	passwordEnv := asMap["password_env"].(string)
+	if passwordEnv != "" {
+		if connection.Password != "" {
+			return nil, fmt.Errorf("only one of console_connection_info.password and console_connection_info.password_env can be set")
+		}
+
+		password, ok := os.LookupEnv(passwordEnv)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s given in console_connection_info.password_env is not set", passwordEnv)
+		}
+		connection.Password = password
+	}
+
+	return connection, nil
+}
+
+// Builds the proxmox vm info to send to the API from the proxmox_vm_info block, if there is one
+func proxmoxFromConfig(d *schema.ResourceData) (*structs.ProxmoxInfo, error) {
+	proxmoxGeneric := d.Get("proxmox_vm_info").([]interface{})
+	if len(proxmoxGeneric) == 0 || proxmoxGeneric[0] == nil {
+		return nil, nil
+	}
+
+	proxmox, err := structs.ProxmoxInfoFromMap(proxmoxGeneric[0].(map[string]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxmox_vm_info: %v", err)
+	}
+	if proxmox.Node == "" {
+		return nil, fmt.Errorf("invalid proxmox_vm_info: node must be set, either directly or through an id in the form {node}/{type}/{id}")
+	}
+	return proxmox, nil
+}
+
+// Makes sure the Proxmox VM that a VM was registered with exists in the cluster
+func checkProxmoxVM(id string, proxmox *structs.ProxmoxInfo, m map[string]string) error {
+	found, err := api.ProxmoxVMExists(id, m)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("Proxmox VM %s was not found, check that the node and VM ID in proxmox_vm_info are correct",
+			proxmox.Reference())
+	}
+	return nil
+}
+
+// Issues a power action if the power state reported by the VM API does not match the desired one, then waits for
+// the VM to report the new state.
+//
+// param id: The ID of the VM
+//
+// param desired: The desired power state. One of "on", "off" or "unmanaged"
+//
+// param timeout: How long to wait for the VM to report the desired state
+//
+// param m: A map containing config info for the provider
+//
+// Returns nil on success or some error on failure
+func applyVMPowerState(id, desired string, timeout time.Duration, m map[string]string) error {
+	if desired == "unmanaged" {
+		return nil
+	}
+
+	info, err := api.GetVMInfo(id, m)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(info.PowerState, desired) {
+		log.Printf("! VM %s is already in power state %s", id, desired)
+		return nil
+	}
+
+	// Older VM APIs report "Unknown" for VMs they can't read the state of, and the state would never reach desired
+	if !util.StrSliceContains(&vmReportedPowerStates, strings.ToLower(info.PowerState)) {
+		return fmt.Errorf("VM API reports power state %q for VM %s, which is not a usable power state. Set "+
+			"power_state to unmanaged to manage this VM without its power state", info.PowerState, id)
+	}
+
+	err = api.ChangeVMPowerState(id, "power-"+desired, m)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"done"},
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			info, err := api.GetVMInfo(id, m)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("! Waiting on VM %s to power %s, power state is %s", id, desired, info.PowerState)
+			if !strings.EqualFold(info.PowerState, desired) {
+				return info, "pending", nil
+			}
+			return info, "done", nil
+		},
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("VM %s did not power %s: %v", id, desired, err)
+	}
+	return nil
+}
+
+// Polls the VM API until the given VM reports the power state requested in a wait_for_ready block and, if asked
+// for, until its console can be opened.
+//
+// param id: The ID of the VM to wait on
+//
+// param isVsphere: Whether the VM is a vSphere VM. Console availability can only be checked for these
+//
+// param wait: The wait_for_ready block as a map
+//
+// param timeout: How long to wait for the VM to become ready
+//
+// param m: A map containing config info for the provider
+//
+// Returns nil once the VM is ready or an error if it does not become ready before the timeout
+func waitForVMReady(id string, isVsphere bool, wait map[string]interface{}, timeout time.Duration, m map[string]string) error {
+	// This has already been validated by the schema
+	interval, _ := time.ParseDuration(wait["poll_interval"].(string))
+	powerState := wait["power_state"].(string)
+	console := wait["console"].(bool)
+
+	if console && !isVsphere {
+		log.Printf("! Console availability can only be checked for vSphere VMs, only waiting on power state")
+		console = false
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"ready"},
+		Timeout:      timeout,
+		PollInterval: interval,
+		Refresh: func() (interface{}, string, error) {
+			info, err := api.GetVMInfo(id, m)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("! Waiting on VM %s, power state is %s", id, info.PowerState)
+			if powerState != "any" && !strings.EqualFold(info.PowerState, powerState) {
+				return info, "pending", nil
+			}
+
+			if console {
+				available, err := api.VMConsoleAvailable(id, m)
+				if err != nil {
+					return nil, "", err
+				}
+				if !available {
+					return info, "pending", nil
+				}
+			}
+
+			return info, "ready", nil
+		},
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("VM %s was registered but did not become ready: %v", id, err)
+	}
+	return nil
+}
@@ -14,12 +14,54 @@ import (
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"crucible_player_virtual_machine":      playerVirtualMachine(),
-			"crucible_player_view":                 playerView(),
-			"crucible_player_application_template": applicationTemplate(),
-			"crucible_player_user":                 user(),
-			"crucible_vlan":                        casterVlan(),
-			"crucible_player_view_network":          playerViewNetwork(),
+			"crucible_player_virtual_machine":        playerVirtualMachine(),
+			"crucible_player_view":                   playerView(),
+			"crucible_player_application_template":   applicationTemplate(),
+			"crucible_player_user":                   user(),
+			"crucible_vlan":                          casterVlan(),
+			"crucible_vlan_pool":                     casterVlanPool(),
+			"crucible_vlan_partition":                casterVlanPartition(),
+			"crucible_vlan_partition_assignment":     casterVlanPartitionAssignment(),
+			"crucible_vlan_set":                      casterVlanSet(),
+			"crucible_player_view_network":           playerViewNetwork(),
+			"crucible_vm_usage_logging_session":      vmUsageLoggingSession(),
+			"crucible_caster_project":                casterProject(),
+			"crucible_caster_directory":              casterDirectory(),
+			"crucible_caster_file":                   casterFile(),
+			"crucible_caster_workspace":              casterWorkspace(),
+			"crucible_caster_workspace_variable":     casterWorkspaceVariable(),
+			"crucible_caster_run":                    casterRun(),
+			"crucible_caster_host":                   casterHost(),
+			"crucible_caster_host_assignment":        casterHostAssignment(),
+			"crucible_caster_module":                 casterModule(),
+			"crucible_caster_design":                 casterDesign(),
+			"crucible_caster_design_module":          casterDesignModule(),
+			"crucible_alloy_event_template":          alloyEventTemplate(),
+			"crucible_alloy_event":                   alloyEvent(),
+			"crucible_steamfitter_scenario_template": steamfitterScenarioTemplate(),
+			"crucible_steamfitter_task":              steamfitterTask(),
+			"crucible_steamfitter_scenario":          steamfitterScenario(),
+			"crucible_blueprint_msel":                blueprintMsel(),
+			"crucible_blueprint_team":                blueprintTeam(),
+			"crucible_blueprint_data_field":          blueprintDataField(),
+			"crucible_blueprint_scenario_event":      blueprintScenarioEvent(),
+			"crucible_cite_scoring_model":            citeScoringModel(),
+			"crucible_cite_evaluation":               citeEvaluation(),
+			"crucible_gallery_collection":            galleryCollection(),
+			"crucible_gallery_exhibit":               galleryExhibit(),
+			"crucible_gallery_card":                  galleryCard(),
+			"crucible_gallery_article":               galleryArticle(),
+			"crucible_topomojo_workspace":            topomojoWorkspace(),
+			"crucible_topomojo_template":             topomojoTemplate(),
+			"crucible_gameboard_game":                gameboardGame(),
+			"crucible_gameboard_challenge_spec":      gameboardChallengeSpec(),
+			"crucible_identity_account":              identityAccount(),
+			"crucible_identity_client":               identityClient(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"crucible_vm_usage_logging_session": vmUsageLoggingSessionDataSource(),
+			"crucible_vlans":                    casterVlansDataSource(),
+			"crucible_caster_hosts":             casterHostsDataSource(),
 		},
 		Schema: map[string]*schema.Schema{
 			"username": {
@@ -30,8 +72,9 @@ func Provider() *schema.Provider {
 				},
 			},
 			"password": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
 				DefaultFunc: func() (interface{}, error) {
 					return os.Getenv("SEI_CRUCIBLE_PASSWORD"), nil
 				},
@@ -71,6 +114,62 @@ func Provider() *schema.Provider {
 					return os.Getenv("SEI_CRUCIBLE_CASTER_API_URL"), nil
 				},
 			},
+			"alloy_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_ALLOY_API_URL"), nil
+				},
+			},
+			"steamfitter_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_STEAMFITTER_API_URL"), nil
+				},
+			},
+			"blueprint_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_BLUEPRINT_API_URL"), nil
+				},
+			},
+			"cite_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_CITE_API_URL"), nil
+				},
+			},
+			"gallery_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_GALLERY_API_URL"), nil
+				},
+			},
+			"topomojo_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_TOPOMOJO_API_URL"), nil
+				},
+			},
+			"gameboard_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_GAMEBOARD_API_URL"), nil
+				},
+			},
+			"identity_api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return os.Getenv("SEI_CRUCIBLE_IDENTITY_API_URL"), nil
+				},
+			},
 			"client_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -79,8 +178,9 @@ func Provider() *schema.Provider {
 				},
 			},
 			"client_secret": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
 				DefaultFunc: func() (interface{}, error) {
 					return os.Getenv("SEI_CRUCIBLE_CLIENT_SECRET"), nil
 				},
@@ -113,6 +213,14 @@ func config(r *schema.ResourceData) (interface{}, error) {
 	vmAPI := r.Get("vm_api_url")
 	playerAPI := r.Get("player_api_url")
 	casterAPI := r.Get("caster_api_url")
+	alloyAPI := r.Get("alloy_api_url")
+	steamfitterAPI := r.Get("steamfitter_api_url")
+	blueprintAPI := r.Get("blueprint_api_url")
+	citeAPI := r.Get("cite_api_url")
+	galleryAPI := r.Get("gallery_api_url")
+	topomojoAPI := r.Get("topomojo_api_url")
+	gameboardAPI := r.Get("gameboard_api_url")
+	identityAPI := r.Get("identity_api_url")
 	id := r.Get("client_id")
 	sec := r.Get("client_secret")
 	scopesInterface := r.Get("client_scopes").([]interface{})
@@ -135,6 +243,14 @@ func config(r *schema.ResourceData) (interface{}, error) {
 	m["vm_api_url"] = vmAPI.(string)
 	m["player_api_url"] = playerAPI.(string)
 	m["caster_api_url"] = casterAPI.(string)
+	m["alloy_api_url"] = alloyAPI.(string)
+	m["steamfitter_api_url"] = steamfitterAPI.(string)
+	m["blueprint_api_url"] = blueprintAPI.(string)
+	m["cite_api_url"] = citeAPI.(string)
+	m["gallery_api_url"] = galleryAPI.(string)
+	m["topomojo_api_url"] = topomojoAPI.(string)
+	m["gameboard_api_url"] = gameboardAPI.(string)
+	m["identity_api_url"] = identityAPI.(string)
 	m["client_id"] = id.(string)
 	m["client_secret"] = sec.(string)
 	m["client_scopes"] = scopes
@@ -0,0 +1,336 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Statuses of a scenario that has been started and not ended
+var steamfitterRunningStatuses = []string{"active", "paused"}
+
+// Statuses of a scenario that can't be started again
+var steamfitterEndedStatuses = []string{"ended", "archived"}
+
+// A Steamfitter scenario created from a scenario template and bound to a Player view. While the scenario is running
+// its tasks run against the view's VMs. An ended scenario can't be started again, so setting running back to true
+// after the scenario ended creates a new one.
+func steamfitterScenario() *schema.Resource {
+	return &schema.Resource{
+		Create: steamfitterScenarioCreate,
+		Read:   steamfitterScenarioRead,
+		Update: steamfitterScenarioUpdate,
+		Delete: steamfitterScenarioDelete,
+
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			status := d.Get("status").(string)
+			if d.HasChange("running") && d.Get("running").(bool) && util.StrSliceContains(&steamfitterEndedStatuses, status) {
+				return d.ForceNew("running")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scenario_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"running": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vm_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Call API to create a scenario from the template, then bind it to the view
+// If running is set, start the scenario
+// Call read to make sure everything worked
+func steamfitterScenarioCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateSteamfitterScenario(d.Get("scenario_template_id").(string), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+	log.Printf("! Steamfitter scenario created with ID %s", d.Id())
+
+	err = api.UpdateSteamfitterScenario(steamfitterScenarioFromConfig(d, created), casted)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("running").(bool) {
+		err = api.StartSteamfitterScenario(d.Id(), casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return steamfitterScenarioRead(d, m)
+}
+
+// Check if scenario exists. If not, set id to "" and return nil
+// Read scenario info and its task results from API
+// Use it to update local state
+func steamfitterScenarioRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.SteamfitterScenarioExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	scenario, err := api.ReadSteamfitterScenario(id, casted)
+	if err != nil {
+		return err
+	}
+
+	results, err := api.ListSteamfitterScenarioResults(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scenario_template_id", scenario.ScenarioTemplateId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("view_id", scenario.ViewId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", scenario.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", scenario.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("running", util.StrSliceContains(&steamfitterRunningStatuses, scenario.Status))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", scenario.Status)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("start_date", scenario.StartDate)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("end_date", scenario.EndDate)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("task_results", steamfitterResultsToList(results))
+}
+
+// Call API to update the scenario's name and description
+// If running changed, start or end the scenario
+// Call read to make sure everything worked
+func steamfitterScenarioUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("name", "description") {
+		scenario, err := api.ReadSteamfitterScenario(d.Id(), casted)
+		if err != nil {
+			return err
+		}
+
+		err = api.UpdateSteamfitterScenario(steamfitterScenarioFromConfig(d, scenario), casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Setting running to true on an ended scenario replaces it, so a scenario started here has never run
+	if d.HasChange("running") {
+		var err error
+		if d.Get("running").(bool) {
+			err = api.StartSteamfitterScenario(d.Id(), casted)
+		} else {
+			err = api.EndSteamfitterScenario(d.Id(), casted)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return steamfitterScenarioRead(d, m)
+}
+
+// Check if scenario exists
+// If it is running, call API to end it. Steamfitter keeps the ended scenario and its results.
+func steamfitterScenarioDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.SteamfitterScenarioExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	scenario, err := api.ReadSteamfitterScenario(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !util.StrSliceContains(&steamfitterRunningStatuses, scenario.Status) {
+		return nil
+	}
+
+	return api.EndSteamfitterScenario(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a scenario struct to update the given scenario with the view, name and description in config. The name and
+// description are copied from the template when they are not set.
+func steamfitterScenarioFromConfig(d *schema.ResourceData, scenario *structs.SteamfitterScenario) *structs.SteamfitterScenario {
+	updated := *scenario
+	updated.ViewId = d.Get("view_id").(string)
+
+	if name, ok := d.GetOk("name"); ok {
+		updated.Name = name.(string)
+	}
+	if description, ok := d.GetOk("description"); ok {
+		updated.Description = description.(string)
+	}
+	return &updated
+}
+
+// Converts task results to the list of maps stored in task_results
+func steamfitterResultsToList(results []structs.SteamfitterResult) []map[string]interface{} {
+	asList := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		asList = append(asList, map[string]interface{}{
+			"task_id":     result.TaskId,
+			"vm_id":       result.VmId,
+			"vm_name":     result.VmName,
+			"status":      result.Status,
+			"output":      result.ActualOutput,
+			"status_date": result.StatusDate,
+		})
+	}
+	return asList
+}
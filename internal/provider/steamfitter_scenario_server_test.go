@@ -0,0 +1,124 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for starting and ending a scenario against a stub of the Steamfitter API
+//
+// Execution steps
+// 1. Terraform creates a running scenario from a template with a new name
+// 2. Verify local state, including the task results
+// 3. Terraform stops the scenario
+// 4. Verify the scenario was ended in place
+// 5. Terraform sets the scenario to running again
+// 6. Verify a new scenario was created, as an ended scenario can't be started again
+// 7. Terraform destroys the scenario
+//
+// Expected behavior:
+// Scenarios are started, ended and replaced as running changes, and destroying a running scenario ends it
+func TestSteamfitterScenario(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	stub.handle("POST", "scenarioTemplates/{id}/scenarios", func(id string, body []byte) (int, interface{}) {
+		scenario := stub.insert("scenarios", map[string]interface{}{
+			"scenarioTemplateId": id,
+			"name":               "Template name",
+			"description":        "Template description",
+			"status":             "ready",
+		})
+		stub.insert("results", map[string]interface{}{
+			"scenarioId":   scenario["id"],
+			"vmName":       "user-1",
+			"status":       "succeeded",
+			"actualOutput": "ok",
+		})
+		return http.StatusCreated, scenario
+	})
+	for action, status := range map[string]string{"start": "active", "end": "ended"} {
+		status := status
+		stub.handle("PUT", "scenarios/{id}/"+action, func(id string, body []byte) (int, interface{}) {
+			scenario := stub.get("scenarios", id)
+			if scenario == nil {
+				return http.StatusNotFound, nil
+			}
+			scenario["status"] = status
+			return http.StatusOK, scenario
+		})
+	}
+
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("scenarios") != 2 || len(stub.find("scenarios", "status", "ended")) != 2 {
+				return fmt.Errorf("expected both scenarios to be ended after destroy")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configSteamfitterScenario(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "name", "Exercise"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "description", "Template description"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "status", "active"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "task_results.#", "1"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "task_results.0.output", "ok"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["crucible_steamfitter_scenario.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configSteamfitterScenario(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "status", "ended"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_steamfitter_scenario.test"].Primary.ID != id {
+							return fmt.Errorf("expected the scenario to be ended in place")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configSteamfitterScenario(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario.test", "status", "active"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_steamfitter_scenario.test"].Primary.ID == id {
+							return fmt.Errorf("expected the ended scenario to be replaced")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configSteamfitterScenario(running bool) string {
+	return fmt.Sprintf(`
+	resource "crucible_steamfitter_scenario" "test" {
+		scenario_template_id = "4a3b2c1d-0e9f-4a8b-b7c6-d5e4f3a2b1c0"
+		view_id              = "2d0a2b6e-7c4b-4f3e-9b1a-6c5d4e3f2a10"
+		name                 = "Exercise"
+		running              = %t
+	}
+	`, running)
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A scenario template holds the tasks a Steamfitter scenario is created with. Tasks are added to it with
+// crucible_steamfitter_task.
+func steamfitterScenarioTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: steamfitterScenarioTemplateCreate,
+		Read:   steamfitterScenarioTemplateRead,
+		Update: steamfitterScenarioTemplateUpdate,
+		Delete: steamfitterScenarioTemplateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"duration_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+// Get scenario template properties from d
+// Call API to create scenario template
+// Call read to make sure everything worked
+func steamfitterScenarioTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateSteamfitterScenarioTemplate(steamfitterScenarioTemplateFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Steamfitter scenario template created with ID %s", d.Id())
+	return steamfitterScenarioTemplateRead(d, m)
+}
+
+// Check if scenario template exists. If not, set id to "" and return nil
+// Read scenario template info from API
+// Use it to update local state
+func steamfitterScenarioTemplateRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.SteamfitterScenarioTemplateExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	template, err := api.ReadSteamfitterScenarioTemplate(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", template.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", template.Description)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("duration_hours", template.DurationHours)
+}
+
+// Get scenario template properties from d
+// Call API to update scenario template
+// Call read to make sure everything worked
+func steamfitterScenarioTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	template := steamfitterScenarioTemplateFromConfig(d)
+	template.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateSteamfitterScenarioTemplate(template, casted)
+	if err != nil {
+		return err
+	}
+
+	return steamfitterScenarioTemplateRead(d, m)
+}
+
+// Check if scenario template exists
+// Call API to delete it. Steamfitter deletes the template's tasks with it.
+func steamfitterScenarioTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.SteamfitterScenarioTemplateExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteSteamfitterScenarioTemplate(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a scenario template struct from the resource's config
+func steamfitterScenarioTemplateFromConfig(d *schema.ResourceData) *structs.SteamfitterScenarioTemplate {
+	return &structs.SteamfitterScenarioTemplate{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		DurationHours: d.Get("duration_hours").(int),
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for the creation and updating of a scenario template and its tasks against a stub of the Steamfitter API
+//
+// Execution steps
+// 1. Terraform creates a scenario template with a task and a second task triggered by the first
+// 2. Verify local state and the tasks in the stub
+// 3. Terraform changes the template's duration and the parameters of the first task
+// 4. Verify the template and task were updated in place
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// Resources are created, updated, and destroyed without error, and the second task points at the first
+func TestSteamfitterScenarioTemplate(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	var taskID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("scenarioTemplates") != 0 || stub.count("tasks") != 0 {
+				return fmt.Errorf("expected no scenario templates or tasks after destroy, found %d and %d",
+					stub.count("scenarioTemplates"), stub.count("tasks"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configSteamfitterScenarioTemplate(1, "ls"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario_template.test", "duration_hours", "1"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_task.first", "parameters.Command", "ls"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_task.second", "trigger_condition", "Success"),
+					resource.TestCheckResourceAttrPair("crucible_steamfitter_task.second", "parent_task_id",
+						"crucible_steamfitter_task.first", "id"),
+					func(s *terraform.State) error {
+						taskID = s.RootModule().Resources["crucible_steamfitter_task.first"].Primary.ID
+						if stub.count("tasks") != 2 {
+							return fmt.Errorf("expected 2 tasks in the stub, found %d", stub.count("tasks"))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configSteamfitterScenarioTemplate(2, "ls -la"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_steamfitter_scenario_template.test", "duration_hours", "2"),
+					resource.TestCheckResourceAttr("crucible_steamfitter_task.first", "parameters.Command", "ls -la"),
+					func(s *terraform.State) error {
+						tasks := stub.find("tasks", "name", "List files")
+						if len(tasks) != 1 || tasks[0]["id"] != taskID {
+							return fmt.Errorf("expected the first task to be updated in place, found %v", tasks)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configSteamfitterScenarioTemplate(durationHours int, command string) string {
+	return fmt.Sprintf(`
+	resource "crucible_steamfitter_scenario_template" "test" {
+		name           = "Exercise"
+		duration_hours = %d
+	}
+
+	resource "crucible_steamfitter_task" "first" {
+		scenario_template_id = crucible_steamfitter_scenario_template.test.id
+		name                 = "List files"
+		action               = "guest_process_run"
+		vm_mask              = "user"
+		parameters = {
+			Command = "%s"
+		}
+	}
+
+	resource "crucible_steamfitter_task" "second" {
+		scenario_template_id = crucible_steamfitter_scenario_template.test.id
+		name                 = "Report"
+		action               = "guest_process_run"
+		parent_task_id       = crucible_steamfitter_task.first.id
+		trigger_condition    = "Success"
+	}
+	`, durationHours, command)
+}
@@ -0,0 +1,290 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The conditions Steamfitter can run a task on. Tasks with a parent run when the parent ends with the condition.
+var steamfitterTriggerConditions = []string{"Manual", "Time", "Success", "Failure", "Completion", "Expiration"}
+
+// A task in a Steamfitter scenario template. Tasks are ordered by pointing them at the parent task whose result
+// triggers them.
+func steamfitterTask() *schema.Resource {
+	return &schema.Resource{
+		Create: steamfitterTaskCreate,
+		Read:   steamfitterTaskRead,
+		Update: steamfitterTaskUpdate,
+		Delete: steamfitterTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"scenario_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vm_mask": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"api_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "stackstorm",
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"expected_output": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trigger_condition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Manual",
+				ValidateFunc: validation.StringInSlice(steamfitterTriggerConditions, false),
+			},
+			"parent_task_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"expiration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"user_executable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// Get task properties from d
+// Call API to add the task to the scenario template
+// Call read to make sure everything worked
+func steamfitterTaskCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateSteamfitterTask(steamfitterTaskFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	log.Printf("! Steamfitter task created with ID %s", d.Id())
+	return steamfitterTaskRead(d, m)
+}
+
+// Check if task exists. If not, set id to "" and return nil
+// Read task info from API
+// Use it to update local state
+func steamfitterTaskRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.SteamfitterTaskExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	task, err := api.ReadSteamfitterTask(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("scenario_template_id", task.ScenarioTemplateId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", task.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", task.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("action", task.Action)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("vm_mask", task.VmMask)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("api_url", task.ApiUrl)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("parameters", task.ActionParameters)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("expected_output", task.ExpectedOutput)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("trigger_condition", task.TriggerCondition)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("parent_task_id", task.TriggerTaskId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("delay_seconds", task.DelaySeconds)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("expiration_seconds", task.ExpirationSeconds)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("user_executable", task.UserExecutable)
+}
+
+// Get task properties from d
+// Call API to update task
+// Call read to make sure everything worked
+func steamfitterTaskUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	task := steamfitterTaskFromConfig(d)
+	task.Id = d.Id()
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateSteamfitterTask(task, casted)
+	if err != nil {
+		return err
+	}
+
+	return steamfitterTaskRead(d, m)
+}
+
+// Check if task exists
+// Call API to delete it
+func steamfitterTaskDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "steamfitter_api_url", "Steamfitter")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.SteamfitterTaskExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteSteamfitterTask(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a task struct from the resource's config
+func steamfitterTaskFromConfig(d *schema.ResourceData) *structs.SteamfitterTask {
+	parameters := make(map[string]string)
+	for name, value := range d.Get("parameters").(map[string]interface{}) {
+		parameters[name] = value.(string)
+	}
+
+	return &structs.SteamfitterTask{
+		ScenarioTemplateId: d.Get("scenario_template_id").(string),
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Action:             d.Get("action").(string),
+		VmMask:             d.Get("vm_mask").(string),
+		ApiUrl:             d.Get("api_url").(string),
+		ActionParameters:   parameters,
+		ExpectedOutput:     d.Get("expected_output").(string),
+		TriggerCondition:   d.Get("trigger_condition").(string),
+		TriggerTaskId:      d.Get("parent_task_id").(string),
+		DelaySeconds:       d.Get("delay_seconds").(int),
+		ExpirationSeconds:  d.Get("expiration_seconds").(int),
+		UserExecutable:     d.Get("user_executable").(bool),
+	}
+}
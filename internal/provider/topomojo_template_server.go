@@ -0,0 +1,267 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A VM template in a TopoMojo workspace. Setting parent_template_id links a published template into the workspace
+// instead of creating a new one, and the linked template takes its name, description and networks from the parent
+// unless they are set.
+func topomojoTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: topomojoTemplateCreate,
+		Read:   topomojoTemplateRead,
+		Update: topomojoTemplateUpdate,
+		Delete: topomojoTemplateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"networks": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"guestinfo": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_published": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"linked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Get template properties from d
+// Call API to link the parent template into the workspace or to create a new template
+// Call read to make sure everything worked
+func topomojoTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	parentID, linking := d.GetOk("parent_template_id")
+	if !linking {
+		if _, ok := d.GetOk("name"); !ok {
+			return fmt.Errorf("name must be set on a template that does not have a parent_template_id")
+		}
+
+		created, err := api.CreateTopomojoTemplate(topomojoTemplateFromConfig(d, &structs.TopomojoTemplate{}), casted)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(created.Id)
+		log.Printf("! TopoMojo template created with ID %s", d.Id())
+		return topomojoTemplateRead(d, m)
+	}
+
+	linked, err := api.LinkTopomojoTemplate(parentID.(string), d.Get("workspace_id").(string), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(linked.Id)
+	log.Printf("! TopoMojo template %s linked with ID %s", parentID, d.Id())
+
+	// Apply anything set in config over what the linked template got from its parent
+	err = api.UpdateTopomojoTemplate(topomojoTemplateFromConfig(d, linked), casted)
+	if err != nil {
+		return err
+	}
+
+	return topomojoTemplateRead(d, m)
+}
+
+// Check if template exists. If not, set id to "" and return nil
+// Read template info from API
+// Use it to update local state
+func topomojoTemplateRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.TopomojoTemplateExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	template, err := api.ReadTopomojoTemplate(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("workspace_id", template.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("parent_template_id", template.ParentId)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", template.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", template.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("networks", template.Networks)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("guestinfo", template.Guestinfo)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_hidden", template.IsHidden)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("is_published", template.IsPublished)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("linked", template.IsLinked)
+}
+
+// Get template properties from d
+// Call API to update template
+// Call read to make sure everything worked
+func topomojoTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	template, err := api.ReadTopomojoTemplate(d.Id(), casted)
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateTopomojoTemplate(topomojoTemplateFromConfig(d, template), casted)
+	if err != nil {
+		return err
+	}
+
+	return topomojoTemplateRead(d, m)
+}
+
+// Check if template exists
+// Call API to delete it. Deleting a linked template leaves its parent alone.
+func topomojoTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.TopomojoTemplateExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteTopomojoTemplate(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a template struct with the fields in config applied over the given template. Fields the template can take
+// from its parent are kept when they are not set.
+func topomojoTemplateFromConfig(d *schema.ResourceData, template *structs.TopomojoTemplate) *structs.TopomojoTemplate {
+	updated := *template
+	updated.WorkspaceId = d.Get("workspace_id").(string)
+	updated.Guestinfo = d.Get("guestinfo").(string)
+	updated.IsHidden = d.Get("is_hidden").(bool)
+	updated.IsPublished = d.Get("is_published").(bool)
+
+	if name, ok := d.GetOk("name"); ok {
+		updated.Name = name.(string)
+	}
+	if description, ok := d.GetOk("description"); ok {
+		updated.Description = description.(string)
+	}
+	if networks, ok := d.GetOk("networks"); ok {
+		updated.Networks = networks.(string)
+	}
+	return &updated
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A TopoMojo workspace and its markdown document. Templates are added to it with crucible_topomojo_template.
+func topomojoWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: topomojoWorkspaceCreate,
+		Read:   topomojoWorkspaceRead,
+		Update: topomojoWorkspaceUpdate,
+		Delete: topomojoWorkspaceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Get workspace properties from d
+// Call API to create workspace, then upload its document
+// Call read to make sure everything worked
+func topomojoWorkspaceCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	created, err := api.CreateTopomojoWorkspace(topomojoWorkspaceFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	if document, ok := d.GetOk("document"); ok {
+		err = api.UploadTopomojoDocument(d.Id(), document.(string), casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("! TopoMojo workspace created with ID %s", d.Id())
+	return topomojoWorkspaceRead(d, m)
+}
+
+// Check if workspace exists. If not, set id to "" and return nil
+// Read workspace info and its document from API
+// Use it to update local state
+func topomojoWorkspaceRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.TopomojoWorkspaceExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	workspace, err := api.ReadTopomojoWorkspace(id, casted)
+	if err != nil {
+		return err
+	}
+
+	document, err := api.ReadTopomojoDocument(id, casted)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", workspace.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", workspace.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("author", workspace.Author)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("audience", workspace.Audience)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("document", document)
+}
+
+// Get workspace properties from d
+// Call API to update workspace and upload its document if either changed
+// Call read to make sure everything worked
+func topomojoWorkspaceUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("name", "description", "author", "audience") {
+		workspace := topomojoWorkspaceFromConfig(d)
+		workspace.Id = d.Id()
+
+		err := api.UpdateTopomojoWorkspace(workspace, casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("document") {
+		err := api.UploadTopomojoDocument(d.Id(), d.Get("document").(string), casted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return topomojoWorkspaceRead(d, m)
+}
+
+// Check if workspace exists
+// Call API to delete it. TopoMojo deletes the workspace's templates and document with it.
+func topomojoWorkspaceDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+	err := util.RequireApiUrl(casted, "topomojo_api_url", "TopoMojo")
+	if err != nil {
+		return err
+	}
+
+	exists, err := api.TopomojoWorkspaceExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteTopomojoWorkspace(id, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a workspace struct from the resource's config
+func topomojoWorkspaceFromConfig(d *schema.ResourceData) *structs.TopomojoWorkspace {
+	return &structs.TopomojoWorkspace{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Author:      d.Get("author").(string),
+		Audience:    d.Get("audience").(string),
+	}
+}
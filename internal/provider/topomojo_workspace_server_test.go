@@ -0,0 +1,157 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/provider"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Test case for workspaces with their documents, new templates and linked templates against a stub of the TopoMojo API
+//
+// Execution steps
+// 1. Terraform creates a workspace with a published template, and a second workspace with a document and a template
+// linked to the first one
+// 2. Verify the linked template took its name and networks from its parent, and the document was uploaded
+// 3. Terraform changes the document and names the linked template
+// 4. Verify the workspace and linked template were updated in place
+// 5. Terraform destroys resources
+//
+// Expected behavior:
+// Resources are created, updated, and destroyed without error. Linked templates keep what they got from their parent
+// unless it is set in config.
+func TestTopomojoWorkspace(t *testing.T) {
+	stub := newAPIStub()
+	defer stub.server.Close()
+
+	documents := make(map[string]string)
+	stub.handle("PUT", "document/{id}", func(id string, body []byte) (int, interface{}) {
+		if stub.get("workspace", id) == nil {
+			return http.StatusNotFound, nil
+		}
+		var document string
+		if err := json.Unmarshal(body, &document); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		documents[id] = document
+		return http.StatusOK, nil
+	})
+	stub.handle("GET", "document/{id}", func(id string, body []byte) (int, interface{}) {
+		if stub.get("workspace", id) == nil {
+			return http.StatusNotFound, nil
+		}
+		return http.StatusOK, documents[id]
+	})
+	stub.handle("POST", "template/link", func(id string, body []byte) (int, interface{}) {
+		var link map[string]string
+		if err := json.Unmarshal(body, &link); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		parent := stub.get("template", link["templateId"])
+		if parent == nil {
+			return http.StatusNotFound, nil
+		}
+		return http.StatusOK, stub.insert("template", map[string]interface{}{
+			"workspaceId": link["workspaceId"],
+			"parentId":    link["templateId"],
+			"name":        parent["name"],
+			"description": parent["description"],
+			"networks":    parent["networks"],
+			"isLinked":    true,
+		})
+	})
+
+	var workspaceID, linkedID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"crucible": provider.Provider(),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if stub.count("workspace") != 0 || stub.count("template") != 0 {
+				return fmt.Errorf("expected no workspaces or templates after destroy, found %d and %d",
+					stub.count("workspace"), stub.count("template"))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stub.providerConfig() + configTopomojoWorkspace("# Lab\nLog in to the console.", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_topomojo_workspace.lab", "document",
+						"# Lab\nLog in to the console."),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.parent", "linked", "false"),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.linked", "linked", "true"),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.linked", "name", "kali"),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.linked", "networks", "lan"),
+					resource.TestCheckResourceAttrPair("crucible_topomojo_template.linked", "parent_template_id",
+						"crucible_topomojo_template.parent", "id"),
+					resource.TestCheckResourceAttrPair("crucible_topomojo_template.linked", "workspace_id",
+						"crucible_topomojo_workspace.lab", "id"),
+					func(s *terraform.State) error {
+						workspaceID = s.RootModule().Resources["crucible_topomojo_workspace.lab"].Primary.ID
+						linkedID = s.RootModule().Resources["crucible_topomojo_template.linked"].Primary.ID
+						if documents[workspaceID] != "# Lab\nLog in to the console." {
+							return fmt.Errorf("expected the document to be uploaded to the stub, found %q",
+								documents[workspaceID])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: stub.providerConfig() + configTopomojoWorkspace("# Lab\nLog in as root.", "attacker"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("crucible_topomojo_workspace.lab", "document",
+						"# Lab\nLog in as root."),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.linked", "name", "attacker"),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.linked", "networks", "lan"),
+					resource.TestCheckResourceAttr("crucible_topomojo_template.parent", "name", "kali"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["crucible_topomojo_workspace.lab"].Primary.ID != workspaceID {
+							return fmt.Errorf("expected the workspace to be updated in place")
+						}
+						if s.RootModule().Resources["crucible_topomojo_template.linked"].Primary.ID != linkedID {
+							return fmt.Errorf("expected the linked template to be updated in place")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func configTopomojoWorkspace(document, linkedName string) string {
+	return fmt.Sprintf(`
+	resource "crucible_topomojo_workspace" "source" {
+		name = "Templates"
+	}
+
+	resource "crucible_topomojo_template" "parent" {
+		workspace_id = crucible_topomojo_workspace.source.id
+		name         = "kali"
+		networks     = "lan"
+		is_published = true
+	}
+
+	resource "crucible_topomojo_workspace" "lab" {
+		name     = "Lab"
+		author   = "SEI"
+		document = %q
+	}
+
+	resource "crucible_topomojo_template" "linked" {
+		workspace_id       = crucible_topomojo_workspace.lab.id
+		parent_template_id = crucible_topomojo_template.parent.id
+		name               = %q
+	}
+	`, document, linkedName)
+}
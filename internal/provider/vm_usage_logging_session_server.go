@@ -0,0 +1,247 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package provider
+
+import (
+	"fmt"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/api"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"github.com/cmu-sei/terraform-provider-crucible/internal/util"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func vmUsageLoggingSession() *schema.Resource {
+	return &schema.Resource{
+		Create: vmUsageLoggingSessionCreate,
+		Read:   vmUsageLoggingSessionRead,
+		Update: vmUsageLoggingSessionUpdate,
+		Delete: vmUsageLoggingSessionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"view_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"session_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"session_start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"session_end": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: util.SuppressEquivalentTimes,
+			},
+			"log_download_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func vmUsageLoggingSessionCreate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+
+	result, err := api.CreateUsageLoggingSession(usageLoggingSessionFromConfig(d), casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(result.ID)
+
+	log.Printf("! Usage logging session created with ID %s", d.Id())
+	return vmUsageLoggingSessionRead(d, m)
+}
+
+func vmUsageLoggingSessionRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.UsageLoggingSessionExists(id, casted)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	session, err := api.GetUsageLoggingSession(id, casted)
+	if err != nil {
+		return err
+	}
+
+	return setUsageLoggingSessionState(d, session, casted)
+}
+
+func vmUsageLoggingSessionUpdate(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	casted := m.(map[string]string)
+
+	session := usageLoggingSessionFromConfig(d)
+	session.ID = d.Id()
+
+	err := api.UpdateUsageLoggingSession(session, casted)
+	if err != nil {
+		return err
+	}
+
+	return vmUsageLoggingSessionRead(d, m)
+}
+
+func vmUsageLoggingSessionDelete(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Id()
+	casted := m.(map[string]string)
+
+	exists, err := api.UsageLoggingSessionExists(id, casted)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return api.DeleteUsageLoggingSession(id, casted)
+}
+
+// Data source that looks up an existing usage logging session and where its log can be downloaded from
+func vmUsageLoggingSessionDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: vmUsageLoggingSessionDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"session_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"session_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"session_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"session_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_download_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func vmUsageLoggingSessionDataSourceRead(d *schema.ResourceData, m interface{}) error {
+	if m == nil {
+		return fmt.Errorf("error configuring provider")
+	}
+
+	id := d.Get("session_id").(string)
+	casted := m.(map[string]string)
+
+	session, err := api.GetUsageLoggingSession(id, casted)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(session.ID)
+	return setUsageLoggingSessionState(d, session, casted)
+}
+
+// -------------------- Helper functions --------------------
+
+// Builds a usage logging session struct from the resource's config
+func usageLoggingSessionFromConfig(d *schema.ResourceData) *structs.UsageLoggingSession {
+	tIDs := d.Get("team_ids").([]interface{})
+
+	return &structs.UsageLoggingSession{
+		ViewID:       d.Get("view_id").(string),
+		TeamIDs:      *util.ToStringSlice(&tIDs),
+		SessionName:  d.Get("session_name").(string),
+		SessionStart: d.Get("session_start").(string),
+		SessionEnd:   d.Get("session_end").(string),
+	}
+}
+
+// Sets the attributes shared by the resource and data source from the remote state of a session
+func setUsageLoggingSessionState(d *schema.ResourceData, session *structs.UsageLoggingSession, m map[string]string) error {
+	err := d.Set("view_id", session.ViewID)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("session_name", session.SessionName)
+	if err != nil {
+		return err
+	}
+
+	// Sort team IDs for consistent state
+	sort.Strings(session.TeamIDs)
+	err = d.Set("team_ids", session.TeamIDs)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("session_start", session.SessionStart)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("session_end", session.SessionEnd)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("log_download_url", api.GetUsageLogDownloadURL(session.ID, m))
+}
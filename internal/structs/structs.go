@@ -25,6 +25,7 @@ type VMInfo struct {
 	Embeddable bool
 	Connection *ConsoleConnection `json:"consoleConnectionInfo"` // Use a pointer so this can be set to nil
 	Proxmox    *ProxmoxInfo       `json:"proxmoxVmInfo"`         // Use a pointer so this can be set to nil
+	PowerState string             `json:"-"`                     // Reported by the API, never sent to it
 }
 
 // ConsoleConnection represents a console connection info block
@@ -65,45 +66,99 @@ type ProxmoxInfo struct {
 	Type string
 }
 
+// ParseProxmoxReference parses a reference to a Proxmox VM. References are either the integer ID of the VM, or
+// the {node}/{type}/{id} form used by the Proxmox provider, e.g. "pve/qemu/100". Node and Type are left blank if
+// the reference is a bare ID. Only the format is checked here, see api.ProxmoxVMExists for looking the VM up.
+func ParseProxmoxReference(ref string) (*ProxmoxInfo, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		if id < 0 {
+			return nil, fmt.Errorf("Proxmox VM ID must not be negative, got %d", id)
+		}
+		return &ProxmoxInfo{Id: id}, nil
+	}
+
+	tokens := strings.Split(ref, "/")
+	if len(tokens) != 3 {
+		return nil, fmt.Errorf("Proxmox VM reference %q must be an integer ID or in the form {node}/{type}/{id}, e.g. pve/qemu/100", ref)
+	}
+
+	if tokens[0] == "" {
+		return nil, fmt.Errorf("Proxmox VM reference %q is missing a node", ref)
+	}
+
+	vmType := strings.ToUpper(tokens[1])
+	if vmType != "QEMU" && vmType != "LXC" {
+		return nil, fmt.Errorf("Proxmox VM reference %q has type %q, expected qemu or lxc", ref, tokens[1])
+	}
+
+	id, err := strconv.Atoi(tokens[2])
+	if err != nil || id < 0 {
+		return nil, fmt.Errorf("Proxmox VM reference %q has ID %q, expected a non-negative integer", ref, tokens[2])
+	}
+
+	return &ProxmoxInfo{
+		Id:   id,
+		Node: tokens[0],
+		Type: vmType,
+	}, nil
+}
+
 // ProxmoxInfoFromMap creates a ProxmoxInfo object from an equivalent map
-func ProxmoxInfoFromMap(m map[string]interface{}) *ProxmoxInfo {
-	// Id is an int in the API, but proxmox provider gives it in the form {node}/{type}/{id}
-	// Check if an int is given directly, or try to parse id from provider id string
-	var intId int
-	id := m["id"]
+//
+// The id field can be any reference accepted by ParseProxmoxReference. If it includes a node and type, they are
+// used when the node and type fields are blank, and must agree with them otherwise. Type defaults to QEMU.
+func ProxmoxInfoFromMap(m map[string]interface{}) (*ProxmoxInfo, error) {
+	var info *ProxmoxInfo
 
-	switch id.(type) {
+	switch id := m["id"].(type) {
 	case string:
 		var err error
-		strId := id.(string)
-		intId, err = strconv.Atoi(strId)
-
+		info, err = ParseProxmoxReference(id)
 		if err != nil {
-			idTokens := strings.Split(strId, "/")
-			intId, err = strconv.Atoi(idTokens[2])
-
-			if err != nil {
-				return nil
-			}
+			return nil, err
 		}
-	default:
+	case float64:
 		// float64 is default JSON unmarshalled number type
-		intId = int(id.(float64))
+		info = &ProxmoxInfo{Id: int(id)}
+	default:
+		return nil, fmt.Errorf("Proxmox VM ID has unexpected type %T", id)
 	}
 
-	return &ProxmoxInfo{
-		Id:   intId,
-		Node: m["node"].(string),
-		Type: m["type"].(string),
+	node, _ := m["node"].(string)
+	if node != "" {
+		if info.Node != "" && info.Node != node {
+			return nil, fmt.Errorf("Proxmox VM node is %q but its ID refers to node %q", node, info.Node)
+		}
+		info.Node = node
 	}
+
+	vmType, _ := m["type"].(string)
+	if vmType != "" {
+		if info.Type != "" && !strings.EqualFold(info.Type, vmType) {
+			return nil, fmt.Errorf("Proxmox VM type is %q but its ID refers to type %q, set type to %q", vmType, info.Type, info.Type)
+		}
+		info.Type = strings.ToUpper(vmType)
+	}
+
+	if info.Type == "" {
+		info.Type = "QEMU"
+	}
+
+	return info, nil
+}
+
+// Reference returns the canonical {node}/{type}/{id} form of a ProxmoxInfo, as used by the Proxmox provider
+func (proxmox ProxmoxInfo) Reference() string {
+	return fmt.Sprintf("%s/%s/%d", proxmox.Node, strings.ToLower(proxmox.Type), proxmox.Id)
 }
 
 // ToMap turns a ProxmoxInfo into an equivalent map.
 func (proxmox ProxmoxInfo) ToMap() map[string]interface{} {
 	return map[string]interface{}{
-		"id":   strconv.Itoa(proxmox.Id),
-		"node": proxmox.Node,
-		"type": proxmox.Type,
+		"id":        strconv.Itoa(proxmox.Id),
+		"node":      proxmox.Node,
+		"type":      proxmox.Type,
+		"reference": proxmox.Reference(),
 	}
 }
 
@@ -415,3 +470,493 @@ type ViewNetworkInfo struct {
 	Name               string
 	TeamIds            []string
 }
+
+// UsageLoggingSession holds information about a VM usage logging session in the VM API. Sessions record which
+// users opened which VM consoles in a view between their start and end times.
+type UsageLoggingSession struct {
+	ID           string
+	ViewID       string
+	TeamIDs      []string
+	SessionName  string
+	SessionStart string
+	SessionEnd   string
+}
+
+// VlanRange is an inclusive range of VLAN IDs
+type VlanRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// VlanRangesFromList creates a slice of VlanRanges from a list of vlan_range blocks
+func VlanRangesFromList(list []interface{}) []VlanRange {
+	ranges := []VlanRange{}
+	for _, entry := range list {
+		asMap := entry.(map[string]interface{})
+		ranges = append(ranges, VlanRange{
+			Start: asMap["start"].(int),
+			End:   asMap["end"].(int),
+		})
+	}
+	return ranges
+}
+
+// ToMap turns a VlanRange into an equivalent map
+func (vlanRange VlanRange) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"start": vlanRange.Start,
+		"end":   vlanRange.End,
+	}
+}
+
+// VlanPool represents a pool of VLANs in Caster. Partitions are carved out of pools.
+type VlanPool struct {
+	Id     string
+	Name   string
+	Ranges []VlanRange
+}
+
+// VlanPartition represents a partition of a VLAN pool in Caster. VLANs are acquired from partitions.
+type VlanPartition struct {
+	Id        string
+	PoolId    string
+	Name      string
+	IsDefault bool
+	Ranges    []VlanRange
+}
+
+// CasterProject represents a project in Caster. Projects hold directories of Terraform configuration.
+type CasterProject struct {
+	Id   string
+	Name string
+}
+
+// CasterDirectory represents a directory in a Caster project. A directory with no parent is at the project's root.
+type CasterDirectory struct {
+	Id               string
+	ProjectId        string
+	ParentId         string
+	Name             string
+	TerraformVersion string
+}
+
+// CasterFile represents a file of Terraform configuration in a Caster directory. Files with a workspace ID only
+// apply to that workspace.
+type CasterFile struct {
+	Id          string
+	DirectoryId string
+	WorkspaceId string
+	Name        string
+	Content     string
+}
+
+// CasterWorkspace represents a workspace in a Caster directory. Each workspace has its own Terraform state.
+type CasterWorkspace struct {
+	Id          string
+	DirectoryId string
+	Name        string
+	DynamicHost bool
+	IsLocked    bool
+}
+
+// CasterRun represents a run of Terraform in a Caster workspace. A run is planned, then applied or rejected.
+type CasterRun struct {
+	Id          string
+	WorkspaceId string
+	IsDestroy   bool
+	Status      string
+	PlanId      string
+	ApplyId     string
+}
+
+// CasterHost represents an ESXi host Caster can deploy workspaces to when dynamic host allocation is used
+type CasterHost struct {
+	Id              string
+	Name            string
+	Datastore       string
+	MaximumMachines int
+	Enabled         bool
+	Development     bool
+	ProjectId       string
+	MachineCount    int
+}
+
+// CasterModule represents a reusable Terraform module published in Caster
+type CasterModule struct {
+	Id          string
+	Name        string
+	Source      string
+	Version     string
+	Description string
+}
+
+// CasterDesign represents a design in a Caster project. Designs are composed of configured modules.
+type CasterDesign struct {
+	Id        string
+	ProjectId string
+	Name      string
+}
+
+// CasterDesignModule represents a module added to a Caster design, along with the values of its variables
+type CasterDesignModule struct {
+	Id            string
+	DesignId      string
+	ModuleId      string
+	Name          string
+	ModuleVersion string
+	Variables     map[string]string
+	Enabled       bool
+}
+
+// AlloyEventTemplate represents an Alloy event template, which ties a Player view, a Caster directory and a
+// Steamfitter scenario template together so events can be launched from it
+type AlloyEventTemplate struct {
+	Id                 string
+	Name               string
+	Description        string
+	ViewId             string
+	DirectoryId        string
+	ScenarioTemplateId string
+	DurationHours      int
+	UseDynamicHost     bool
+	IsPublished        bool
+}
+
+// AlloyEvent represents an event launched from an Alloy event template. While it is launched, Alloy clones the
+// template's view, applies a Caster workspace and starts a Steamfitter scenario for it.
+type AlloyEvent struct {
+	Id                       string
+	EventTemplateId          string
+	Name                     string
+	Status                   string
+	InternalStatus           string
+	FailureCount             int
+	LastLaunchInternalStatus string
+	LastEndInternalStatus    string
+	ViewId                   string
+	WorkspaceId              string
+	ScenarioId               string
+	ExpirationDate           string
+}
+
+// SteamfitterScenarioTemplate represents a Steamfitter scenario template, a reusable set of tasks that scenarios are
+// created from
+type SteamfitterScenarioTemplate struct {
+	Id            string
+	Name          string
+	Description   string
+	DurationHours int
+}
+
+// SteamfitterTask represents a task in a Steamfitter scenario template or scenario. A task runs an action against
+// the VMs matching its mask, either on its own or when the task it is triggered by completes.
+type SteamfitterTask struct {
+	Id                 string
+	Name               string
+	Description        string
+	ScenarioTemplateId string
+	ScenarioId         string
+	Action             string
+	VmMask             string
+	ApiUrl             string
+	ActionParameters   map[string]string
+	ExpectedOutput     string
+	ExpirationSeconds  int
+	DelaySeconds       int
+	TriggerTaskId      string
+	TriggerCondition   string
+	UserExecutable     bool
+}
+
+// SteamfitterScenario represents a Steamfitter scenario, a copy of a scenario template's tasks that runs against the
+// VMs in a Player view
+type SteamfitterScenario struct {
+	Id                 string
+	Name               string
+	Description        string
+	ScenarioTemplateId string
+	ViewId             string
+	Status             string
+	StartDate          string
+	EndDate            string
+}
+
+// SteamfitterResult represents the result of running a Steamfitter task against one VM
+type SteamfitterResult struct {
+	Id           string
+	TaskId       string
+	VmId         string
+	VmName       string
+	Status       string
+	ActualOutput string
+	StatusDate   string
+}
+
+// BlueprintMsel represents a Master Scenario Events List in Blueprint, the plan of an exercise's events and the teams
+// they involve
+type BlueprintMsel struct {
+	Id              string
+	Name            string
+	Description     string
+	Status          string
+	IsTemplate      bool
+	PlayerViewId    string
+	UseGallery      bool
+	UseCite         bool
+	UseSteamfitter  bool
+	StartTime       string
+	DurationSeconds int
+}
+
+// BlueprintTeam represents a team that takes part in a MSEL
+type BlueprintTeam struct {
+	Id        string
+	MselId    string
+	Name      string
+	ShortName string
+	Email     string
+}
+
+// BlueprintDataField represents a column of a MSEL. Each scenario event has a data value for each data field.
+type BlueprintDataField struct {
+	Id                string
+	MselId            string
+	Name              string
+	DataType          string
+	DisplayOrder      int
+	IsChosenFromList  bool
+	IsInitiallyHidden bool
+}
+
+// BlueprintScenarioEvent represents an event in a MSEL. The columns of the event are held in data values, one for
+// each of the MSEL's data fields.
+type BlueprintScenarioEvent struct {
+	Id                string
+	MselId            string
+	GroupOrder        int
+	DeltaSeconds      int
+	ScenarioEventType string
+	Description       string
+	IsHidden          bool
+	DataValues        []BlueprintDataValue
+}
+
+// BlueprintDataValue represents the value of one data field of a scenario event
+type BlueprintDataValue struct {
+	Id          string
+	DataFieldId string
+	Value       string
+}
+
+// CiteScoringModel represents a CITE scoring model, the categories and options an incident is scored against
+type CiteScoringModel struct {
+	Id                  string
+	Description         string
+	Status              string
+	CalculationEquation string
+}
+
+// CiteScoringCategory represents a category of a CITE scoring model
+type CiteScoringCategory struct {
+	Id                     string
+	ScoringModelId         string
+	Description            string
+	DisplayOrder           int
+	CalculationEquation    string
+	ScoringWeight          float64
+	IsModifierRequired     bool
+	ScoringOptionSelection string
+}
+
+// CiteScoringOption represents an option that can be chosen in a CITE scoring category
+type CiteScoringOption struct {
+	Id                string
+	ScoringCategoryId string
+	Description       string
+	DisplayOrder      int
+	Value             float64
+	IsModifier        bool
+}
+
+// CiteEvaluation represents a CITE evaluation, in which teams score an incident move by move
+type CiteEvaluation struct {
+	Id                   string
+	Description          string
+	ScoringModelId       string
+	Status               string
+	CurrentMoveNumber    int
+	SituationTime        string
+	SituationDescription string
+	ViewId               string
+}
+
+// CiteMove represents a move of a CITE evaluation, a point in the incident at which teams score it
+type CiteMove struct {
+	Id                   string
+	EvaluationId         string
+	MoveNumber           int
+	Description          string
+	SituationTime        string
+	SituationDescription string
+}
+
+// CiteTeam represents a team taking part in a CITE evaluation
+type CiteTeam struct {
+	Id           string
+	EvaluationId string
+	Name         string
+	ShortName    string
+	TeamTypeId   string
+}
+
+// GalleryCollection represents a Gallery collection, the cards and articles that make up an exercise's information
+// feeds
+type GalleryCollection struct {
+	Id          string
+	Name        string
+	Description string
+}
+
+// GalleryExhibit represents a Gallery exhibit, one showing of a collection to a set of teams. The exhibit tracks the
+// move and inject the exercise is at.
+type GalleryExhibit struct {
+	Id            string
+	CollectionId  string
+	ScenarioId    string
+	CurrentMove   int
+	CurrentInject int
+}
+
+// GalleryTeam represents a team that views a Gallery exhibit
+type GalleryTeam struct {
+	Id        string
+	ExhibitId string
+	Name      string
+	ShortName string
+}
+
+// GalleryCard represents a card in a Gallery collection, a topic that articles are grouped under
+type GalleryCard struct {
+	Id           string
+	CollectionId string
+	Name         string
+	Description  string
+	Move         int
+	Inject       int
+}
+
+// GalleryTeamCard represents a card being shown to a team, from the move and inject given
+type GalleryTeamCard struct {
+	Id              string
+	TeamId          string
+	CardId          string
+	Move            int
+	Inject          int
+	IsShownOnWall   bool
+	CanPostArticles bool
+}
+
+// GalleryArticle represents an article in a Gallery collection, released to the teams that see its card at the
+// move and inject given
+type GalleryArticle struct {
+	Id           string
+	CollectionId string
+	ExhibitId    string
+	CardId       string
+	Name         string
+	Summary      string
+	Description  string
+	Move         int
+	Inject       int
+	SourceType   string
+	SourceName   string
+	Url          string
+	DatePosted   string
+	OpenInNewTab bool
+}
+
+// TopomojoWorkspace represents a TopoMojo workspace, a lab environment made of VM templates with a markdown document
+// for its instructions
+type TopomojoWorkspace struct {
+	Id          string
+	Name        string
+	Description string
+	Author      string
+	Audience    string
+}
+
+// TopomojoTemplate represents a VM template in a TopoMojo workspace. A linked template refers to a published template
+// from another workspace instead of its own disk.
+type TopomojoTemplate struct {
+	Id          string
+	WorkspaceId string
+	ParentId    string
+	Name        string
+	Description string
+	Networks    string
+	Guestinfo   string
+	IsHidden    bool
+	IsPublished bool
+	IsLinked    bool
+}
+
+// GameboardGame represents a Gameboard game, the competition players register teams for and play challenges in
+type GameboardGame struct {
+	Id                       string
+	Name                     string
+	Competition              string
+	Season                   string
+	Track                    string
+	Division                 string
+	GameStart                string
+	GameEnd                  string
+	RegistrationType         string
+	RegistrationOpen         string
+	RegistrationClose        string
+	MinTeamSize              int
+	MaxTeamSize              int
+	SessionMinutes           int
+	SessionLimit             int
+	GamespaceLimitPerSession int
+	MaxAttempts              int
+	IsPublished              bool
+}
+
+// GameboardChallengeSpec represents a challenge in a Gameboard game. The external ID is the ID of the challenge in
+// its game engine, which for TopoMojo is the ID of the workspace.
+type GameboardChallengeSpec struct {
+	Id             string
+	GameId         string
+	ExternalId     string
+	GameEngineType string
+	Name           string
+	Description    string
+	Tag            string
+	Points         int
+	Disabled       bool
+}
+
+// IdentityAccount represents an account in the Crucible identity server. The global ID is the subject of the tokens
+// the account signs in with, and is the ID the account's user has in the other Crucible applications.
+type IdentityAccount struct {
+	Id          int
+	GlobalId    string
+	Username    string
+	Password    string
+	DisplayName string
+	Role        string
+	Status      string
+}
+
+// IdentityClient represents an OAuth client registered in the Crucible identity server. Grants and scopes are space
+// separated lists.
+type IdentityClient struct {
+	Id           int
+	Name         string
+	DisplayName  string
+	Description  string
+	Grants       string
+	Scopes       string
+	RedirectUrls []string
+	Enabled      bool
+}
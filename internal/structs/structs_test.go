@@ -0,0 +1,92 @@
+// Copyright 2022 Carnegie Mellon University. All Rights Reserved.
+// Released under a MIT (SEI)-style license. See LICENSE.md in the project root for license information.
+
+package structs_test
+
+import (
+	"github.com/cmu-sei/terraform-provider-crucible/internal/structs"
+	"reflect"
+	"testing"
+)
+
+// Test parsing of the reference formats accepted in proxmox_vm_info.id
+func TestProxmoxInfoFromMap(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    map[string]interface{}
+		expected *structs.ProxmoxInfo
+		wantErr  bool
+	}{
+		{
+			name:     "bare id with node",
+			input:    map[string]interface{}{"id": "100", "node": "pve", "type": ""},
+			expected: &structs.ProxmoxInfo{Id: 100, Node: "pve", Type: "QEMU"},
+		},
+		{
+			name:     "provider reference",
+			input:    map[string]interface{}{"id": "pve/lxc/101", "node": "", "type": ""},
+			expected: &structs.ProxmoxInfo{Id: 101, Node: "pve", Type: "LXC"},
+		},
+		{
+			name:     "provider reference agreeing with fields",
+			input:    map[string]interface{}{"id": "pve/qemu/102", "node": "pve", "type": "QEMU"},
+			expected: &structs.ProxmoxInfo{Id: 102, Node: "pve", Type: "QEMU"},
+		},
+		{
+			name:     "number from API",
+			input:    map[string]interface{}{"id": float64(103), "node": "pve", "type": "QEMU"},
+			expected: &structs.ProxmoxInfo{Id: 103, Node: "pve", Type: "QEMU"},
+		},
+		{
+			name:    "conflicting node",
+			input:   map[string]interface{}{"id": "pve/qemu/100", "node": "pve2", "type": ""},
+			wantErr: true,
+		},
+		{
+			name:    "conflicting type",
+			input:   map[string]interface{}{"id": "pve/qemu/100", "node": "", "type": "LXC"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			input:   map[string]interface{}{"id": "pve/vm/100", "node": "", "type": ""},
+			wantErr: true,
+		},
+		{
+			name:    "missing id",
+			input:   map[string]interface{}{"id": "pve/qemu/", "node": "", "type": ""},
+			wantErr: true,
+		},
+		{
+			name:    "wrong number of parts",
+			input:   map[string]interface{}{"id": "qemu/100", "node": "", "type": ""},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := structs.ProxmoxInfoFromMap(c.input)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %+v", c.name, actual)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, actual)
+		}
+	}
+}
+
+// Test that the canonical reference matches the format used by the proxmox provider
+func TestProxmoxReference(t *testing.T) {
+	info := structs.ProxmoxInfo{Id: 100, Node: "pve", Type: "QEMU"}
+	if ref := info.Reference(); ref != "pve/qemu/100" {
+		t.Errorf("expected pve/qemu/100, got %s", ref)
+	}
+}
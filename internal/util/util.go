@@ -5,9 +5,17 @@ package util
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"golang.org/x/oauth2"
 )
 
@@ -22,8 +30,23 @@ func ToStringSlice(data *[]interface{}) *[]string {
 	return &converted
 }
 
-// GetAuth gets an auth token.
+// Tokens are cached so that resources making many API calls don't request a new token for each one
+var tokenCache = make(map[string]*oauth2.Token)
+var tokenCacheLock sync.Mutex
+
+// GetAuth gets an auth token. Tokens are reused until they expire.
 func GetAuth(m map[string]string) (string, error) {
+	cacheKey := tokenCacheKey(m)
+
+	// The lock is only held to access the cache, so a slow token request doesn't hold up other API calls
+	tokenCacheLock.Lock()
+	tok, ok := tokenCache[cacheKey]
+	tokenCacheLock.Unlock()
+
+	if ok && tok.Valid() {
+		return tok.AccessToken, nil
+	}
+
 	scopes := strings.Split(m["client_scopes"], ",")
 
 	if len(scopes) == 0 || (len(scopes) == 1 && scopes[0] == "") {
@@ -44,9 +67,69 @@ func GetAuth(m map[string]string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+
+	tokenCacheLock.Lock()
+	tokenCache[cacheKey] = tok
+	tokenCacheLock.Unlock()
+
 	return tok.AccessToken, nil
 }
 
+// Returns the key a token is cached under, which is a hash of every setting used to request it. Providers that
+// differ in any of them, such as two aliases with different credentials, get their own tokens.
+func tokenCacheKey(m map[string]string) string {
+	hash := sha256.New()
+	for _, setting := range []string{"auth_url", "player_token_url", "client_id", "client_secret", "client_scopes",
+		"username", "password"} {
+		hash.Write([]byte(m[setting]))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ForEachConcurrently calls fn once for every index from 0 to count - 1, running at most limit calls at a time.
+//
+// param count: The number of times to call fn
+//
+// param limit: The maximum number of calls to run at once
+//
+// param fn: The function to call with each index
+//
+// Returns a slice with the error returned by each call, or nil if every call succeeded
+func ForEachConcurrently(count, limit int, fn func(i int) error) []error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	errs := make([]error, count)
+	failed := false
+	var failedLock sync.Mutex
+
+	semaphore := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := fn(i); err != nil {
+				errs[i] = err
+				failedLock.Lock()
+				failed = true
+				failedLock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if !failed {
+		return nil
+	}
+	return errs
+}
+
 // PairInList returns true if a given key/value pair exists somewhere in a list of maps
 func PairInList(list []interface{}, key, value string) bool {
 	for _, curr := range list {
@@ -88,6 +171,132 @@ func StrSliceContains(arr *[]string, str string) bool {
 	return false
 }
 
+// ValidateDuration is a schema ValidateFunc that ensures a string can be parsed by time.ParseDuration
+func ValidateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration such as \"30s\" or \"5m\", got %q", k, v.(string))}
+	}
+	return nil, nil
+}
+
+// EquivalentTimes returns true if two timestamps refer to the same instant. APIs often return timestamps in a
+// different format than they were given in, so this is used to suppress diffs on time attributes. Timestamps
+// without a zone are assumed to be UTC.
+func EquivalentTimes(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	timeA, errA := parseTime(a)
+	timeB, errB := parseTime(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return timeA.Equal(timeB)
+}
+
+// SuppressEquivalentTimes is a DiffSuppressFunc for time attributes that ignores a change when the old and new values
+// refer to the same instant
+func SuppressEquivalentTimes(k, old, new string, d *schema.ResourceData) bool {
+	return EquivalentTimes(old, new)
+}
+
+// TimesInOrder returns false if the timestamp first is after the timestamp second. Empty or unparseable timestamps
+// are not compared, as they are caught by validation or filled in by the API.
+func TimesInOrder(first, second string) bool {
+	timeFirst, errFirst := parseTime(first)
+	timeSecond, errSecond := parseTime(second)
+	if errFirst != nil || errSecond != nil {
+		return true
+	}
+	return !timeFirst.After(timeSecond)
+}
+
+// Parses a timestamp in RFC 3339 format, with or without a zone
+func parseTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Parse("2006-01-02T15:04:05.999999999", value)
+	}
+	return parsed, nil
+}
+
+// Redact returns a representation of v that is safe to write to the debug log. The values of any fields that look
+// like credentials are replaced, and requests and responses are reduced to their method, URL and status so their
+// Authorization headers are never logged.
+//
+// param v: A map, struct, slice, JSON string or []byte, *http.Request or *http.Response
+//
+// Returns the redacted value as a string
+func Redact(v interface{}) string {
+	var generic interface{}
+
+	switch value := v.(type) {
+	case nil:
+		return "<nil>"
+	case *http.Request:
+		return value.Method + " " + value.URL.String()
+	case *http.Response:
+		if value.Request != nil {
+			return fmt.Sprintf("%s from %s %s", value.Status, value.Request.Method, value.Request.URL.String())
+		}
+		return value.Status
+	case string:
+		if json.Unmarshal([]byte(value), &generic) != nil {
+			return value
+		}
+	case []byte:
+		if json.Unmarshal(value, &generic) != nil {
+			return string(value)
+		}
+	default:
+		// Round trip through JSON so structs and maps can be walked the same way
+		asJSON, err := json.Marshal(value)
+		if err != nil || json.Unmarshal(asJSON, &generic) != nil {
+			return "<unable to redact value>"
+		}
+	}
+
+	asJSON, err := json.Marshal(redactValue(generic))
+	if err != nil {
+		return "<unable to redact value>"
+	}
+	return string(asJSON)
+}
+
+// Recursively replaces the values of sensitive keys within a value unmarshalled from JSON
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, inner := range value {
+			if isSensitiveKey(key) && inner != nil && inner != "" {
+				value[key] = "<redacted>"
+			} else {
+				value[key] = redactValue(inner)
+			}
+		}
+	case []interface{}:
+		for i, inner := range value {
+			value[i] = redactValue(inner)
+		}
+	}
+	return v
+}
+
+// Returns true if a field with the given name should never be logged
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.ReplaceAll(key, "_", ""))
+	if strings.Contains(normalized, "password") || strings.Contains(normalized, "secret") {
+		return true
+	}
+
+	switch normalized {
+	case "token", "accesstoken", "refreshtoken", "authorization", "apikey":
+		return true
+	}
+	return false
+}
+
 // Returns the normalized url for the player api
 func GetPlayerApiUrl(m map[string]string) string {
 	return GetApiUrl(m, "player_api_url")
@@ -103,6 +312,54 @@ func GetCasterApiUrl(m map[string]string) string {
 	return GetApiUrl(m, "caster_api_url")
 }
 
+// Returns the normalized url for the alloy api
+func GetAlloyApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "alloy_api_url")
+}
+
+// Returns the normalized url for the steamfitter api
+func GetSteamfitterApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "steamfitter_api_url")
+}
+
+// Returns the normalized url for the blueprint api
+func GetBlueprintApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "blueprint_api_url")
+}
+
+// Returns the normalized url for the cite api
+func GetCiteApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "cite_api_url")
+}
+
+// Returns the normalized url for the gallery api
+func GetGalleryApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "gallery_api_url")
+}
+
+// Returns the normalized url for the topomojo api
+func GetTopomojoApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "topomojo_api_url")
+}
+
+// Returns the normalized url for the gameboard api
+func GetGameboardApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "gameboard_api_url")
+}
+
+// Returns the normalized url for the identity api
+func GetIdentityApiUrl(m map[string]string) string {
+	return GetApiUrl(m, "identity_api_url")
+}
+
+// RequireApiUrl returns an error naming the setting if an optional api url was not set in the provider block
+func RequireApiUrl(m map[string]string, urlName, service string) error {
+	if m[urlName] == "" {
+		return fmt.Errorf("%s must be set in the provider block to manage %s resources", urlName, service)
+	}
+	return nil
+}
+
 // GetApiUrl returns a url from the settings map, normalized to end in /api/
 //
 // param m: The settings map